@@ -0,0 +1,10 @@
+data "ovirt_cluster" "test" {
+  name            = "Default"
+  datacenter_name = "Default"
+}
+
+resource "ovirt_vm" "test" {
+  name        = "hello_world"
+  cluster_id  = data.ovirt_cluster.test.id
+  template_id = "00000000-0000-0000-0000-000000000000"
+}
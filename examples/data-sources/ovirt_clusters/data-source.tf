@@ -0,0 +1,7 @@
+data "ovirt_clusters" "test" {
+  name_regex = "^production-.*$"
+}
+
+output "cluster_ids" {
+  value = data.ovirt_clusters.test.clusters[*].id
+}
@@ -0,0 +1,12 @@
+data "ovirt_storage_domain" "test" {
+  name   = "data"
+  status = "active"
+}
+
+resource "ovirt_disk" "test" {
+  storagedomain_id = data.ovirt_storage_domain.test.id
+  format           = "raw"
+  size             = 1048576
+  alias            = "test"
+  sparse           = true
+}
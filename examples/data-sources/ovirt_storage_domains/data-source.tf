@@ -0,0 +1,13 @@
+data "ovirt_storage_domains" "test" {
+  status        = "active"
+  min_available = 10737418240
+}
+
+# Place the disk on the emptiest active storage domain.
+resource "ovirt_disk" "test" {
+  storagedomain_id = data.ovirt_storage_domains.test.storage_domains[0].id
+  format           = "raw"
+  size             = 1048576
+  alias            = "test"
+  sparse           = true
+}
@@ -0,0 +1,9 @@
+data "ovirt_template" "test" {
+  name = "Blank"
+}
+
+resource "ovirt_vm" "test" {
+  name        = "hello_world"
+  cluster_id  = var.cluster_id
+  template_id = data.ovirt_template.test.id
+}
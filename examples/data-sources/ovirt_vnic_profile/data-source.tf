@@ -0,0 +1,17 @@
+data "ovirt_vnic_profile" "test" {
+  name            = "ovirtmgmt"
+  network_name    = "ovirtmgmt"
+  datacenter_name = "Default"
+}
+
+resource "ovirt_vm" "test" {
+  name        = "hello_world"
+  cluster_id  = var.cluster_id
+  template_id = "00000000-0000-0000-0000-000000000000"
+}
+
+resource "ovirt_nic" "test" {
+  name            = "eth0"
+  vm_id           = ovirt_vm.test.id
+  vnic_profile_id = data.ovirt_vnic_profile.test.id
+}
@@ -0,0 +1,6 @@
+resource "ovirt_disk_from_image" "test" {
+  storagedomain_id = var.storagedomain_id
+  source_file      = "./fedora-coreos.qcow2"
+  alias            = "fedora-coreos"
+  sparse           = true
+}
@@ -0,0 +1,207 @@
+package ovirt
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ovirtclient "github.com/ovirt/go-ovirt-client"
+)
+
+var clusterDataSourceSchema = map[string]*schema.Schema{
+	"id": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "oVirt ID of the cluster.",
+	},
+	"name": {
+		Type:          schema.TypeString,
+		Optional:      true,
+		Computed:      true,
+		ConflictsWith: []string{"name_regex"},
+		Description:   "Exact name of the cluster to look up.",
+	},
+	"name_regex": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ConflictsWith:    []string{"name"},
+		ValidateDiagFunc: validateRegexp,
+		Description:      "Regular expression the name of the cluster must match.",
+	},
+	"datacenter_id": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Computed:         true,
+		ConflictsWith:    []string{"datacenter_name"},
+		ValidateDiagFunc: validateUUID,
+		Description:      "ID of the data center the cluster must belong to.",
+	},
+	"datacenter_name": {
+		Type:          schema.TypeString,
+		Optional:      true,
+		Computed:      true,
+		ConflictsWith: []string{"datacenter_id"},
+		Description:   "Name of the data center the cluster must belong to.",
+	},
+}
+
+func (p *provider) clusterDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: p.clusterDataSourceRead,
+		Schema:      clusterDataSourceSchema,
+		Description: "The ovirt_cluster data source looks up a single cluster by name, name pattern, or data center. The lookup fails if not exactly one cluster matches.",
+	}
+}
+
+func (p *provider) clusterDataSourceRead(
+	ctx context.Context,
+	data *schema.ResourceData,
+	meta interface{},
+) diag.Diagnostics {
+	client := meta.(ovirtclient.Client)
+	filter, diags := extractClusterFilter(data)
+	if diags.HasError() {
+		return diags
+	}
+	clusters, err := findClusters(client, filter, ovirtclient.ContextStrategy(ctx))
+	if err != nil {
+		return errorToDiags("list clusters", err)
+	}
+	switch len(clusters) {
+	case 0:
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "No cluster found",
+				Detail:   "No cluster matched the specified criteria.",
+			},
+		}
+	case 1:
+		return clusterDataSourceUpdate(clusters[0], data)
+	default:
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Multiple clusters found",
+				Detail: fmt.Sprintf(
+					"%d clusters matched the specified criteria, please narrow your search or use the ovirt_clusters data source.",
+					len(clusters),
+				),
+			},
+		}
+	}
+}
+
+func clusterDataSourceUpdate(cluster clusterWithDatacenter, data *schema.ResourceData) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	data.SetId(cluster.ID())
+	diags = setResourceField(data, "name", cluster.Name(), diags)
+	diags = setResourceField(data, "datacenter_id", cluster.datacenterID, diags)
+	diags = setResourceField(data, "datacenter_name", cluster.datacenterName, diags)
+	return diags
+}
+
+// clusterWithDatacenter is a cluster with the data center it belongs to. The data center fields are empty if the
+// cluster is not part of any data center.
+type clusterWithDatacenter struct {
+	ovirtclient.Cluster
+
+	datacenterID   string
+	datacenterName string
+}
+
+// clusterFilter contains the criteria a cluster must match. Empty criteria match all clusters.
+type clusterFilter struct {
+	name           string
+	nameRegexp     *regexp.Regexp
+	datacenterID   string
+	datacenterName string
+}
+
+func (f clusterFilter) matches(cluster clusterWithDatacenter) bool {
+	if f.name != "" && cluster.Name() != f.name {
+		return false
+	}
+	if f.nameRegexp != nil && !f.nameRegexp.MatchString(cluster.Name()) {
+		return false
+	}
+	if f.datacenterID != "" && cluster.datacenterID != f.datacenterID {
+		return false
+	}
+	if f.datacenterName != "" && cluster.datacenterName != f.datacenterName {
+		return false
+	}
+	return true
+}
+
+func extractClusterFilter(data *schema.ResourceData) (clusterFilter, diag.Diagnostics) {
+	filter := clusterFilter{}
+	if name, ok := data.GetOk("name"); ok {
+		filter.name = name.(string)
+	}
+	if nameRegex, ok := data.GetOk("name_regex"); ok {
+		nameRegexp, err := regexp.Compile(nameRegex.(string))
+		if err != nil {
+			return filter, errorToDiags("parse name_regex", err)
+		}
+		filter.nameRegexp = nameRegexp
+	}
+	if datacenterID, ok := data.GetOk("datacenter_id"); ok {
+		filter.datacenterID = datacenterID.(string)
+	}
+	if datacenterName, ok := data.GetOk("datacenter_name"); ok {
+		filter.datacenterName = datacenterName.(string)
+	}
+	return filter, nil
+}
+
+// findClusters lists all clusters matching the filter, ordered by name. Since clusters do not carry the data center
+// they belong to, this function lists the clusters of each data center to build the mapping.
+func findClusters(
+	client ovirtclient.Client,
+	filter clusterFilter,
+	retry ovirtclient.RetryStrategy,
+) ([]clusterWithDatacenter, error) {
+	datacenters, err := client.ListDatacenters(retry)
+	if err != nil {
+		return nil, err
+	}
+	clusterDatacenters := map[string]ovirtclient.Datacenter{}
+	for _, datacenter := range datacenters {
+		datacenterClusters, err := client.ListDatacenterClusters(datacenter.ID(), retry)
+		if err != nil {
+			return nil, err
+		}
+		for _, cluster := range datacenterClusters {
+			clusterDatacenters[cluster.ID()] = datacenter
+		}
+	}
+
+	clusters, err := client.ListClusters(retry)
+	if err != nil {
+		return nil, err
+	}
+	var result []clusterWithDatacenter
+	for _, cluster := range clusters {
+		item := clusterWithDatacenter{
+			Cluster: cluster,
+		}
+		if datacenter, ok := clusterDatacenters[cluster.ID()]; ok {
+			item.datacenterID = datacenter.ID()
+			item.datacenterName = datacenter.Name()
+		}
+		if filter.matches(item) {
+			result = append(result, item)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Name() != result[j].Name() {
+			return result[i].Name() < result[j].Name()
+		}
+		return result[i].ID() < result[j].ID()
+	})
+	return result, nil
+}
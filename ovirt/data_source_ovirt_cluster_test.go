@@ -0,0 +1,98 @@
+package ovirt
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	ovirtclient "github.com/ovirt/go-ovirt-client"
+	ovirtclientlog "github.com/ovirt/go-ovirt-client-log/v2"
+)
+
+func TestClusterDataSource(t *testing.T) {
+	t.Parallel()
+
+	p := newProvider(ovirtclientlog.NewTestLogger(t))
+	clusterID := p.getTestHelper().GetClusterID()
+	cluster, err := p.getTestHelper().GetClient().GetCluster(clusterID)
+	if err != nil {
+		t.Fatalf("failed to fetch test cluster (%v)", err)
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: p.getProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(
+					`
+provider "ovirt" {
+	mock = true
+}
+
+data "ovirt_cluster" "test" {
+	name = "%s"
+}
+`,
+					cluster.Name(),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(
+						"data.ovirt_cluster.test",
+						"id",
+						regexp.MustCompile(fmt.Sprintf("^%s$", regexp.QuoteMeta(clusterID))),
+					),
+					resource.TestMatchResourceAttr(
+						"data.ovirt_cluster.test",
+						"datacenter_id",
+						regexp.MustCompile("^.+$"),
+					),
+				),
+			},
+		},
+	})
+}
+
+func TestFindClusters(t *testing.T) {
+	t.Parallel()
+
+	helper := newProvider(ovirtclientlog.NewTestLogger(t)).getTestHelper()
+	client := helper.GetClient()
+	clusterID := helper.GetClusterID()
+	datacenters, err := client.ListDatacenters()
+	if err != nil {
+		t.Fatalf("failed to list datacenters (%v)", err)
+	}
+	if len(datacenters) == 0 {
+		t.Fatalf("no datacenters in test environment")
+	}
+
+	clusters, err := findClusters(client, clusterFilter{}, ovirtclient.AutoRetry())
+	if err != nil {
+		t.Fatalf("failed to list clusters (%v)", err)
+	}
+	found := false
+	for _, cluster := range clusters {
+		if cluster.ID() == clusterID {
+			found = true
+			if cluster.datacenterID == "" {
+				t.Fatalf("the data center ID was not filled for cluster %s", clusterID)
+			}
+		}
+	}
+	if !found {
+		t.Fatalf("the test cluster %s was not found", clusterID)
+	}
+
+	clusters, err = findClusters(
+		client,
+		clusterFilter{nameRegexp: regexp.MustCompile("^this-cluster-does-not-exist$")},
+		ovirtclient.AutoRetry(),
+	)
+	if err != nil {
+		t.Fatalf("failed to list clusters (%v)", err)
+	}
+	if len(clusters) != 0 {
+		t.Fatalf("the name filter did not exclude clusters")
+	}
+}
@@ -0,0 +1,108 @@
+package ovirt
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ovirtclient "github.com/ovirt/go-ovirt-client"
+)
+
+var clustersDataSourceSchema = map[string]*schema.Schema{
+	"id": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Meta-identifier calculated from the IDs of the found clusters.",
+	},
+	"name": {
+		Type:          schema.TypeString,
+		Optional:      true,
+		ConflictsWith: []string{"name_regex"},
+		Description:   "Exact name of the clusters to look up.",
+	},
+	"name_regex": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ConflictsWith:    []string{"name"},
+		ValidateDiagFunc: validateRegexp,
+		Description:      "Regular expression the name of the clusters must match.",
+	},
+	"datacenter_id": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ConflictsWith:    []string{"datacenter_name"},
+		ValidateDiagFunc: validateUUID,
+		Description:      "ID of the data center the clusters must belong to.",
+	},
+	"datacenter_name": {
+		Type:          schema.TypeString,
+		Optional:      true,
+		ConflictsWith: []string{"datacenter_id"},
+		Description:   "Name of the data center the clusters must belong to.",
+	},
+	"clusters": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "List of clusters matching the criteria, ordered by name.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"datacenter_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"datacenter_name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	},
+}
+
+func (p *provider) clustersDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: p.clustersDataSourceRead,
+		Schema:      clustersDataSourceSchema,
+		Description: "The ovirt_clusters data source lists all clusters matching the specified name, name pattern, or data center.",
+	}
+}
+
+func (p *provider) clustersDataSourceRead(
+	ctx context.Context,
+	data *schema.ResourceData,
+	meta interface{},
+) diag.Diagnostics {
+	client := meta.(ovirtclient.Client)
+	filter, diags := extractClusterFilter(data)
+	if diags.HasError() {
+		return diags
+	}
+	clusters, err := findClusters(client, filter, ovirtclient.ContextStrategy(ctx))
+	if err != nil {
+		return errorToDiags("list clusters", err)
+	}
+
+	ids := make([]string, len(clusters))
+	result := make([]map[string]interface{}, len(clusters))
+	for i, cluster := range clusters {
+		ids[i] = cluster.ID()
+		result[i] = map[string]interface{}{
+			"id":              cluster.ID(),
+			"name":            cluster.Name(),
+			"datacenter_id":   cluster.datacenterID,
+			"datacenter_name": cluster.datacenterName,
+		}
+	}
+	data.SetId(strconv.Itoa(schema.HashString(strings.Join(ids, ","))))
+	return setResourceField(data, "clusters", result, diag.Diagnostics{})
+}
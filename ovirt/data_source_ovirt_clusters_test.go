@@ -0,0 +1,46 @@
+package ovirt
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	ovirtclientlog "github.com/ovirt/go-ovirt-client-log/v2"
+)
+
+func TestClustersDataSource(t *testing.T) {
+	t.Parallel()
+
+	p := newProvider(ovirtclientlog.NewTestLogger(t))
+	clusterID := p.getTestHelper().GetClusterID()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: p.getProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "ovirt" {
+	mock = true
+}
+
+data "ovirt_clusters" "test" {
+	name_regex = ".*"
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(
+						"data.ovirt_clusters.test",
+						"clusters.#",
+						regexp.MustCompile("^1$"),
+					),
+					resource.TestMatchResourceAttr(
+						"data.ovirt_clusters.test",
+						"clusters.0.id",
+						regexp.MustCompile(fmt.Sprintf("^%s$", regexp.QuoteMeta(clusterID))),
+					),
+				),
+			},
+		},
+	})
+}
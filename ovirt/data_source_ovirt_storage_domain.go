@@ -0,0 +1,188 @@
+package ovirt
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ovirtclient "github.com/ovirt/go-ovirt-client"
+)
+
+var storageDomainDataSourceSchema = map[string]*schema.Schema{
+	"id": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "oVirt ID of the storage domain.",
+	},
+	"name": {
+		Type:          schema.TypeString,
+		Optional:      true,
+		Computed:      true,
+		ConflictsWith: []string{"name_regex"},
+		Description:   "Exact name of the storage domain to look up.",
+	},
+	"name_regex": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ConflictsWith:    []string{"name"},
+		ValidateDiagFunc: validateRegexp,
+		Description:      "Regular expression the name of the storage domain must match.",
+	},
+	"status": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Computed:         true,
+		ValidateDiagFunc: validateStorageDomainStatus,
+		Description:      "Status the storage domain must be in, for example `active`.",
+	},
+	"min_available": {
+		Type:             schema.TypeInt,
+		Optional:         true,
+		ValidateDiagFunc: validateNonNegative,
+		Description:      "Minimum free space in bytes the storage domain must have.",
+	},
+	"available": {
+		Type:        schema.TypeInt,
+		Computed:    true,
+		Description: "Free space on the storage domain in bytes.",
+	},
+	"external_status": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "External status of the storage domain as reported by the storage provider.",
+	},
+}
+
+func (p *provider) storageDomainDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: p.storageDomainDataSourceRead,
+		Schema:      storageDomainDataSourceSchema,
+		Description: "The ovirt_storage_domain data source looks up a single storage domain by name, name pattern, status, or free space. The lookup fails if not exactly one storage domain matches.",
+	}
+}
+
+func (p *provider) storageDomainDataSourceRead(
+	ctx context.Context,
+	data *schema.ResourceData,
+	meta interface{},
+) diag.Diagnostics {
+	client := meta.(ovirtclient.Client)
+	filter, diags := extractStorageDomainFilter(data)
+	if diags.HasError() {
+		return diags
+	}
+	storageDomains, err := findStorageDomains(client, filter, ovirtclient.ContextStrategy(ctx))
+	if err != nil {
+		return errorToDiags("list storage domains", err)
+	}
+	switch len(storageDomains) {
+	case 0:
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "No storage domain found",
+				Detail:   "No storage domain matched the specified criteria.",
+			},
+		}
+	case 1:
+		return storageDomainDataSourceUpdate(storageDomains[0], data)
+	default:
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Multiple storage domains found",
+				Detail: fmt.Sprintf(
+					"%d storage domains matched the specified criteria, please narrow your search or use the ovirt_storage_domains data source.",
+					len(storageDomains),
+				),
+			},
+		}
+	}
+}
+
+func storageDomainDataSourceUpdate(
+	storageDomain ovirtclient.StorageDomain,
+	data *schema.ResourceData,
+) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	data.SetId(storageDomain.ID())
+	diags = setResourceField(data, "name", storageDomain.Name(), diags)
+	diags = setResourceField(data, "status", string(storageDomain.Status()), diags)
+	diags = setResourceField(data, "available", int(storageDomain.Available()), diags)
+	diags = setResourceField(data, "external_status", string(storageDomain.ExternalStatus()), diags)
+	return diags
+}
+
+// storageDomainFilter contains the criteria a storage domain must match. Empty criteria match all storage domains.
+type storageDomainFilter struct {
+	name         string
+	nameRegexp   *regexp.Regexp
+	status       ovirtclient.StorageDomainStatus
+	minAvailable uint64
+}
+
+func (f storageDomainFilter) matches(storageDomain ovirtclient.StorageDomain) bool {
+	if f.name != "" && storageDomain.Name() != f.name {
+		return false
+	}
+	if f.nameRegexp != nil && !f.nameRegexp.MatchString(storageDomain.Name()) {
+		return false
+	}
+	if f.status != "" && storageDomain.Status() != f.status {
+		return false
+	}
+	if storageDomain.Available() < f.minAvailable {
+		return false
+	}
+	return true
+}
+
+func extractStorageDomainFilter(data *schema.ResourceData) (storageDomainFilter, diag.Diagnostics) {
+	filter := storageDomainFilter{}
+	if name, ok := data.GetOk("name"); ok {
+		filter.name = name.(string)
+	}
+	if nameRegex, ok := data.GetOk("name_regex"); ok {
+		nameRegexp, err := regexp.Compile(nameRegex.(string))
+		if err != nil {
+			return filter, errorToDiags("parse name_regex", err)
+		}
+		filter.nameRegexp = nameRegexp
+	}
+	if status, ok := data.GetOk("status"); ok {
+		filter.status = ovirtclient.StorageDomainStatus(status.(string))
+	}
+	if minAvailable, ok := data.GetOk("min_available"); ok {
+		filter.minAvailable = uint64(minAvailable.(int))
+	}
+	return filter, nil
+}
+
+// findStorageDomains lists all storage domains matching the filter, ordered by free space in descending order, so
+// the first storage domain is the emptiest one.
+func findStorageDomains(
+	client ovirtclient.Client,
+	filter storageDomainFilter,
+	retry ovirtclient.RetryStrategy,
+) ([]ovirtclient.StorageDomain, error) {
+	storageDomains, err := client.ListStorageDomains(retry)
+	if err != nil {
+		return nil, err
+	}
+	var result []ovirtclient.StorageDomain
+	for _, storageDomain := range storageDomains {
+		if filter.matches(storageDomain) {
+			result = append(result, storageDomain)
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		if result[i].Available() != result[j].Available() {
+			return result[i].Available() > result[j].Available()
+		}
+		return result[i].Name() < result[j].Name()
+	})
+	return result, nil
+}
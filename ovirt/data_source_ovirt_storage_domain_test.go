@@ -0,0 +1,105 @@
+package ovirt
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	ovirtclient "github.com/ovirt/go-ovirt-client"
+	ovirtclientlog "github.com/ovirt/go-ovirt-client-log/v2"
+)
+
+func TestStorageDomainDataSource(t *testing.T) {
+	t.Parallel()
+
+	p := newProvider(ovirtclientlog.NewTestLogger(t))
+	storageDomainID := p.getTestHelper().GetStorageDomainID()
+	storageDomain, err := p.getTestHelper().GetClient().GetStorageDomain(storageDomainID)
+	if err != nil {
+		t.Fatalf("failed to fetch test storage domain (%v)", err)
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: p.getProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(
+					`
+provider "ovirt" {
+	mock = true
+}
+
+data "ovirt_storage_domain" "test" {
+	name   = "%s"
+	status = "active"
+}
+`,
+					storageDomain.Name(),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(
+						"data.ovirt_storage_domain.test",
+						"id",
+						regexp.MustCompile(fmt.Sprintf("^%s$", regexp.QuoteMeta(storageDomainID))),
+					),
+					resource.TestMatchResourceAttr(
+						"data.ovirt_storage_domain.test",
+						"available",
+						regexp.MustCompile(fmt.Sprintf("^%d$", storageDomain.Available())),
+					),
+				),
+			},
+		},
+	})
+}
+
+func TestFindStorageDomains(t *testing.T) {
+	t.Parallel()
+
+	helper := newProvider(ovirtclientlog.NewTestLogger(t)).getTestHelper()
+	client := helper.GetClient()
+	storageDomainID := helper.GetStorageDomainID()
+	storageDomain, err := client.GetStorageDomain(storageDomainID)
+	if err != nil {
+		t.Fatalf("failed to fetch test storage domain (%v)", err)
+	}
+
+	storageDomains, err := findStorageDomains(
+		client,
+		storageDomainFilter{
+			status:       ovirtclient.StorageDomainStatusActive,
+			minAvailable: storageDomain.Available(),
+		},
+		ovirtclient.AutoRetry(),
+	)
+	if err != nil {
+		t.Fatalf("failed to list storage domains (%v)", err)
+	}
+	found := false
+	for i, sd := range storageDomains {
+		if sd.ID() == storageDomainID {
+			found = true
+		}
+		if i > 0 && storageDomains[i-1].Available() < sd.Available() {
+			t.Fatalf("the storage domains are not ordered by free space")
+		}
+	}
+	if !found {
+		t.Fatalf("the test storage domain %s was not found", storageDomainID)
+	}
+
+	storageDomains, err = findStorageDomains(
+		client,
+		storageDomainFilter{minAvailable: storageDomain.Available() + 1},
+		ovirtclient.AutoRetry(),
+	)
+	if err != nil {
+		t.Fatalf("failed to list storage domains (%v)", err)
+	}
+	for _, sd := range storageDomains {
+		if sd.ID() == storageDomainID {
+			t.Fatalf("the free space filter did not exclude storage domain %s", storageDomainID)
+		}
+	}
+}
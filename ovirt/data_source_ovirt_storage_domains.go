@@ -0,0 +1,112 @@
+package ovirt
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ovirtclient "github.com/ovirt/go-ovirt-client"
+)
+
+var storageDomainsDataSourceSchema = map[string]*schema.Schema{
+	"id": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Meta-identifier calculated from the IDs of the found storage domains.",
+	},
+	"name": {
+		Type:          schema.TypeString,
+		Optional:      true,
+		ConflictsWith: []string{"name_regex"},
+		Description:   "Exact name of the storage domains to look up.",
+	},
+	"name_regex": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ConflictsWith:    []string{"name"},
+		ValidateDiagFunc: validateRegexp,
+		Description:      "Regular expression the name of the storage domains must match.",
+	},
+	"status": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ValidateDiagFunc: validateStorageDomainStatus,
+		Description:      "Status the storage domains must be in, for example `active`.",
+	},
+	"min_available": {
+		Type:             schema.TypeInt,
+		Optional:         true,
+		ValidateDiagFunc: validateNonNegative,
+		Description:      "Minimum free space in bytes the storage domains must have.",
+	},
+	"storage_domains": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "List of storage domains matching the criteria, ordered by free space in descending order.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"status": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"available": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"external_status": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	},
+}
+
+func (p *provider) storageDomainsDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: p.storageDomainsDataSourceRead,
+		Schema:      storageDomainsDataSourceSchema,
+		Description: "The ovirt_storage_domains data source lists all storage domains matching the specified name, name pattern, status, or free space. The emptiest storage domain is listed first.",
+	}
+}
+
+func (p *provider) storageDomainsDataSourceRead(
+	ctx context.Context,
+	data *schema.ResourceData,
+	meta interface{},
+) diag.Diagnostics {
+	client := meta.(ovirtclient.Client)
+	filter, diags := extractStorageDomainFilter(data)
+	if diags.HasError() {
+		return diags
+	}
+	storageDomains, err := findStorageDomains(client, filter, ovirtclient.ContextStrategy(ctx))
+	if err != nil {
+		return errorToDiags("list storage domains", err)
+	}
+
+	ids := make([]string, len(storageDomains))
+	result := make([]map[string]interface{}, len(storageDomains))
+	for i, storageDomain := range storageDomains {
+		ids[i] = storageDomain.ID()
+		result[i] = map[string]interface{}{
+			"id":              storageDomain.ID(),
+			"name":            storageDomain.Name(),
+			"status":          string(storageDomain.Status()),
+			"available":       int(storageDomain.Available()),
+			"external_status": string(storageDomain.ExternalStatus()),
+		}
+	}
+	data.SetId(strconv.Itoa(schema.HashString(strings.Join(ids, ","))))
+	return setResourceField(data, "storage_domains", result, diag.Diagnostics{})
+}
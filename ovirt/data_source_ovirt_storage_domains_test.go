@@ -0,0 +1,47 @@
+package ovirt
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	ovirtclientlog "github.com/ovirt/go-ovirt-client-log/v2"
+)
+
+func TestStorageDomainsDataSource(t *testing.T) {
+	t.Parallel()
+
+	p := newProvider(ovirtclientlog.NewTestLogger(t))
+	storageDomainID := p.getTestHelper().GetStorageDomainID()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: p.getProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "ovirt" {
+	mock = true
+}
+
+data "ovirt_storage_domains" "test" {
+	status        = "active"
+	min_available = 1
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(
+						"data.ovirt_storage_domains.test",
+						"storage_domains.#",
+						regexp.MustCompile("^1$"),
+					),
+					resource.TestMatchResourceAttr(
+						"data.ovirt_storage_domains.test",
+						"storage_domains.0.id",
+						regexp.MustCompile(fmt.Sprintf("^%s$", regexp.QuoteMeta(storageDomainID))),
+					),
+				),
+			},
+		},
+	})
+}
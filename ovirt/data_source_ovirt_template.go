@@ -0,0 +1,91 @@
+package ovirt
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ovirtclient "github.com/ovirt/go-ovirt-client"
+)
+
+var templateDataSourceSchema = map[string]*schema.Schema{
+	"id": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "oVirt ID of the template.",
+	},
+	"name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ValidateDiagFunc: validateNonEmpty,
+		Description:      "Exact name of the template to look up.",
+	},
+	"description": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Description of the template.",
+	},
+}
+
+func (p *provider) templateDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: p.templateDataSourceRead,
+		Schema:      templateDataSourceSchema,
+		Description: `The ovirt_template data source looks up a single template by name. The lookup fails if not exactly one template has the specified name.
+
+-> Versioned templates are not supported yet. All versions of a template share its name, so looking up a template that has more than one version fails with a "Multiple templates found" error.`,
+	}
+}
+
+func (p *provider) templateDataSourceRead(
+	ctx context.Context,
+	data *schema.ResourceData,
+	meta interface{},
+) diag.Diagnostics {
+	client := meta.(ovirtclient.Client)
+	name := data.Get("name").(string)
+
+	templates, err := client.ListTemplates(ovirtclient.ContextStrategy(ctx))
+	if err != nil {
+		return errorToDiags("list templates", err)
+	}
+	var found []ovirtclient.Template
+	for _, template := range templates {
+		if template.Name() == name {
+			found = append(found, template)
+		}
+	}
+	switch len(found) {
+	case 0:
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "No template found",
+				Detail:   fmt.Sprintf("No template with the name %s found.", name),
+			},
+		}
+	case 1:
+		return templateDataSourceUpdate(found[0], data)
+	default:
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Multiple templates found",
+				Detail: fmt.Sprintf(
+					"%d templates have the name %s, please make sure the template name is unique.",
+					len(found),
+					name,
+				),
+			},
+		}
+	}
+}
+
+func templateDataSourceUpdate(template ovirtclient.Template, data *schema.ResourceData) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	data.SetId(template.ID())
+	diags = setResourceField(data, "name", template.Name(), diags)
+	diags = setResourceField(data, "description", template.Description(), diags)
+	return diags
+}
@@ -0,0 +1,48 @@
+package ovirt
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	ovirtclientlog "github.com/ovirt/go-ovirt-client-log/v2"
+)
+
+func TestTemplateDataSource(t *testing.T) {
+	t.Parallel()
+
+	p := newProvider(ovirtclientlog.NewTestLogger(t))
+	templateID := p.getTestHelper().GetBlankTemplateID()
+	template, err := p.getTestHelper().GetClient().GetTemplate(templateID)
+	if err != nil {
+		t.Fatalf("failed to fetch test template (%v)", err)
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: p.getProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(
+					`
+provider "ovirt" {
+	mock = true
+}
+
+data "ovirt_template" "test" {
+	name = "%s"
+}
+`,
+					template.Name(),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(
+						"data.ovirt_template.test",
+						"id",
+						regexp.MustCompile(fmt.Sprintf("^%s$", regexp.QuoteMeta(templateID))),
+					),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,201 @@
+package ovirt
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ovirtclient "github.com/ovirt/go-ovirt-client"
+)
+
+var vnicProfileDataSourceSchema = map[string]*schema.Schema{
+	"id": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "oVirt ID of the VNIC profile.",
+	},
+	"name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ValidateDiagFunc: validateNonEmpty,
+		Description:      "Exact name of the VNIC profile to look up.",
+	},
+	"network_name": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Computed:    true,
+		Description: "Name of the network the VNIC profile must belong to.",
+	},
+	"network_id": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "ID of the network the VNIC profile belongs to.",
+	},
+	"datacenter_id": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Computed:         true,
+		ConflictsWith:    []string{"datacenter_name"},
+		ValidateDiagFunc: validateUUID,
+		Description:      "ID of the data center the network of the VNIC profile must belong to.",
+	},
+	"datacenter_name": {
+		Type:          schema.TypeString,
+		Optional:      true,
+		Computed:      true,
+		ConflictsWith: []string{"datacenter_id"},
+		Description:   "Name of the data center the network of the VNIC profile must belong to.",
+	},
+}
+
+func (p *provider) vnicProfileDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: p.vnicProfileDataSourceRead,
+		Schema:      vnicProfileDataSourceSchema,
+		Description: "The ovirt_vnic_profile data source looks up a single VNIC profile by its name and the name of its network and data center. The lookup fails if not exactly one VNIC profile matches.",
+	}
+}
+
+func (p *provider) vnicProfileDataSourceRead(
+	ctx context.Context,
+	data *schema.ResourceData,
+	meta interface{},
+) diag.Diagnostics {
+	client := meta.(ovirtclient.Client)
+	filter := extractVNICProfileFilter(data)
+	profiles, err := findVNICProfiles(client, filter, ovirtclient.ContextStrategy(ctx))
+	if err != nil {
+		return errorToDiags("list VNIC profiles", err)
+	}
+	switch len(profiles) {
+	case 0:
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "No VNIC profile found",
+				Detail:   "No VNIC profile matched the specified criteria.",
+			},
+		}
+	case 1:
+		return vnicProfileDataSourceUpdate(profiles[0], data)
+	default:
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Multiple VNIC profiles found",
+				Detail: fmt.Sprintf(
+					"%d VNIC profiles matched the specified criteria, please specify the network_name or data center.",
+					len(profiles),
+				),
+			},
+		}
+	}
+}
+
+func vnicProfileDataSourceUpdate(profile vnicProfileWithNetwork, data *schema.ResourceData) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	data.SetId(profile.ID())
+	diags = setResourceField(data, "name", profile.Name(), diags)
+	diags = setResourceField(data, "network_id", profile.NetworkID(), diags)
+	diags = setResourceField(data, "network_name", profile.networkName, diags)
+	diags = setResourceField(data, "datacenter_id", profile.datacenterID, diags)
+	diags = setResourceField(data, "datacenter_name", profile.datacenterName, diags)
+	return diags
+}
+
+// vnicProfileWithNetwork is a VNIC profile with the network and data center it belongs to. The network and data
+// center fields are empty if the network of the profile could not be found.
+type vnicProfileWithNetwork struct {
+	ovirtclient.VNICProfile
+
+	networkName    string
+	datacenterID   string
+	datacenterName string
+}
+
+// vnicProfileFilter contains the criteria a VNIC profile must match. Empty criteria match all VNIC profiles.
+type vnicProfileFilter struct {
+	name           string
+	networkName    string
+	datacenterID   string
+	datacenterName string
+}
+
+func (f vnicProfileFilter) matches(profile vnicProfileWithNetwork) bool {
+	if f.name != "" && profile.Name() != f.name {
+		return false
+	}
+	if f.networkName != "" && profile.networkName != f.networkName {
+		return false
+	}
+	if f.datacenterID != "" && profile.datacenterID != f.datacenterID {
+		return false
+	}
+	if f.datacenterName != "" && profile.datacenterName != f.datacenterName {
+		return false
+	}
+	return true
+}
+
+func extractVNICProfileFilter(data *schema.ResourceData) vnicProfileFilter {
+	filter := vnicProfileFilter{
+		name: data.Get("name").(string),
+	}
+	if networkName, ok := data.GetOk("network_name"); ok {
+		filter.networkName = networkName.(string)
+	}
+	if datacenterID, ok := data.GetOk("datacenter_id"); ok {
+		filter.datacenterID = datacenterID.(string)
+	}
+	if datacenterName, ok := data.GetOk("datacenter_name"); ok {
+		filter.datacenterName = datacenterName.(string)
+	}
+	return filter
+}
+
+// findVNICProfiles lists all VNIC profiles matching the filter. Since VNIC profiles only carry the ID of their
+// network, this function lists all networks and data centers to resolve their names.
+func findVNICProfiles(
+	client ovirtclient.Client,
+	filter vnicProfileFilter,
+	retry ovirtclient.RetryStrategy,
+) ([]vnicProfileWithNetwork, error) {
+	datacenters, err := client.ListDatacenters(retry)
+	if err != nil {
+		return nil, err
+	}
+	datacenterNames := map[string]string{}
+	for _, datacenter := range datacenters {
+		datacenterNames[datacenter.ID()] = datacenter.Name()
+	}
+
+	networks, err := client.ListNetworks(retry)
+	if err != nil {
+		return nil, err
+	}
+	networksByID := map[string]ovirtclient.Network{}
+	for _, network := range networks {
+		networksByID[network.ID()] = network
+	}
+
+	profiles, err := client.ListVNICProfiles(retry)
+	if err != nil {
+		return nil, err
+	}
+	var result []vnicProfileWithNetwork
+	for _, profile := range profiles {
+		item := vnicProfileWithNetwork{
+			VNICProfile: profile,
+		}
+		if network, ok := networksByID[profile.NetworkID()]; ok {
+			item.networkName = network.Name()
+			item.datacenterID = network.DatacenterID()
+			item.datacenterName = datacenterNames[network.DatacenterID()]
+		}
+		if filter.matches(item) {
+			result = append(result, item)
+		}
+	}
+	return result, nil
+}
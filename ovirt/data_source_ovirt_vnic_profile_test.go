@@ -0,0 +1,115 @@
+package ovirt
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	ovirtclient "github.com/ovirt/go-ovirt-client"
+	ovirtclientlog "github.com/ovirt/go-ovirt-client-log/v2"
+)
+
+func TestVNICProfileDataSource(t *testing.T) {
+	t.Parallel()
+
+	p := newProvider(ovirtclientlog.NewTestLogger(t))
+	client := p.getTestHelper().GetClient()
+	profileID := p.getTestHelper().GetVNICProfileID()
+	profile, err := client.GetVNICProfile(profileID)
+	if err != nil {
+		t.Fatalf("failed to fetch test VNIC profile (%v)", err)
+	}
+	network, err := profile.Network()
+	if err != nil {
+		t.Fatalf("failed to fetch network of test VNIC profile (%v)", err)
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: p.getProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(
+					`
+provider "ovirt" {
+	mock = true
+}
+
+data "ovirt_vnic_profile" "test" {
+	name         = "%s"
+	network_name = "%s"
+}
+`,
+					profile.Name(),
+					network.Name(),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(
+						"data.ovirt_vnic_profile.test",
+						"id",
+						regexp.MustCompile(fmt.Sprintf("^%s$", regexp.QuoteMeta(profileID))),
+					),
+					resource.TestMatchResourceAttr(
+						"data.ovirt_vnic_profile.test",
+						"network_id",
+						regexp.MustCompile(fmt.Sprintf("^%s$", regexp.QuoteMeta(network.ID()))),
+					),
+				),
+			},
+		},
+	})
+}
+
+func TestFindVNICProfiles(t *testing.T) {
+	t.Parallel()
+
+	helper := newProvider(ovirtclientlog.NewTestLogger(t)).getTestHelper()
+	client := helper.GetClient()
+	profileID := helper.GetVNICProfileID()
+	profile, err := client.GetVNICProfile(profileID)
+	if err != nil {
+		t.Fatalf("failed to fetch test VNIC profile (%v)", err)
+	}
+	network, err := profile.Network()
+	if err != nil {
+		t.Fatalf("failed to fetch network of test VNIC profile (%v)", err)
+	}
+	datacenter, err := network.Datacenter()
+	if err != nil {
+		t.Fatalf("failed to fetch data center of test network (%v)", err)
+	}
+
+	profiles, err := findVNICProfiles(
+		client,
+		vnicProfileFilter{
+			name:           profile.Name(),
+			networkName:    network.Name(),
+			datacenterName: datacenter.Name(),
+		},
+		ovirtclient.AutoRetry(),
+	)
+	if err != nil {
+		t.Fatalf("failed to list VNIC profiles (%v)", err)
+	}
+	if len(profiles) != 1 || profiles[0].ID() != profileID {
+		t.Fatalf("the test VNIC profile %s was not found", profileID)
+	}
+	if profiles[0].datacenterID != datacenter.ID() {
+		t.Fatalf("incorrect data center ID for VNIC profile %s: %s", profileID, profiles[0].datacenterID)
+	}
+
+	profiles, err = findVNICProfiles(
+		client,
+		vnicProfileFilter{
+			name:        profile.Name(),
+			networkName: "this-network-does-not-exist",
+		},
+		ovirtclient.AutoRetry(),
+	)
+	if err != nil {
+		t.Fatalf("failed to list VNIC profiles (%v)", err)
+	}
+	if len(profiles) != 0 {
+		t.Fatalf("the network name filter did not exclude VNIC profiles")
+	}
+}
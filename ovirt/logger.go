@@ -0,0 +1,206 @@
+package ovirt
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ovirtclientlog "github.com/ovirt/go-ovirt-client-log/v2"
+)
+
+// newTerraformLogger creates a logger that forwards all messages to the Terraform provider log. Terraform picks up
+// the log level from the [LEVEL] prefix of each line and filters the messages according to TF_LOG.
+func newTerraformLogger() ovirtclientlog.Logger {
+	return &terraformLogger{}
+}
+
+type terraformLogger struct{}
+
+func (t *terraformLogger) Debugf(format string, args ...interface{}) {
+	t.logf("DEBUG", format, args...)
+}
+
+func (t *terraformLogger) Infof(format string, args ...interface{}) {
+	t.logf("INFO", format, args...)
+}
+
+func (t *terraformLogger) Warningf(format string, args ...interface{}) {
+	t.logf("WARN", format, args...)
+}
+
+func (t *terraformLogger) Errorf(format string, args ...interface{}) {
+	t.logf("ERROR", format, args...)
+}
+
+func (t *terraformLogger) logf(level string, format string, args ...interface{}) {
+	log.Printf("[%s] ovirt: %s", level, fmt.Sprintf(format, args...))
+}
+
+// redactedValue is the text that replaces passwords, tokens, and other secrets in log messages.
+const redactedValue = "***"
+
+var redactExpressions = []*regexp.Regexp{
+	regexp.MustCompile(`(?i)((?:authorization)\s*[:=]\s*(?:bearer|basic)?\s*)[^\s,;"']+`),
+	regexp.MustCompile(`(?i)(bearer\s+)[^\s,;"']+`),
+	regexp.MustCompile(`(?i)((?:password|passwd|secret|token)["']?\s*[:=]\s*["']?)[^\s,;&"']+`),
+}
+
+// newRedactingLogger wraps the backend logger and removes passwords, tokens, and the passed secrets from all
+// messages before they are forwarded. If the backend already redacts messages, its secrets are merged into the new
+// logger instead of wrapping it, so that each message only passes through the redaction once.
+func newRedactingLogger(backend ovirtclientlog.Logger, secrets ...string) ovirtclientlog.Logger {
+	if existing, ok := backend.(*redactingLogger); ok {
+		backend = existing.backend
+		secrets = append(secrets, existing.secrets...)
+	}
+	nonEmptySecrets := make([]string, 0, len(secrets))
+	for _, secret := range secrets {
+		if secret != "" {
+			nonEmptySecrets = append(nonEmptySecrets, secret)
+		}
+	}
+	return &redactingLogger{
+		backend: backend,
+		secrets: nonEmptySecrets,
+	}
+}
+
+type redactingLogger struct {
+	backend ovirtclientlog.Logger
+	secrets []string
+}
+
+func (r *redactingLogger) Debugf(format string, args ...interface{}) {
+	r.backend.Debugf("%s", r.redact(fmt.Sprintf(format, args...)))
+}
+
+func (r *redactingLogger) Infof(format string, args ...interface{}) {
+	r.backend.Infof("%s", r.redact(fmt.Sprintf(format, args...)))
+}
+
+func (r *redactingLogger) Warningf(format string, args ...interface{}) {
+	r.backend.Warningf("%s", r.redact(fmt.Sprintf(format, args...)))
+}
+
+func (r *redactingLogger) Errorf(format string, args ...interface{}) {
+	r.backend.Errorf("%s", r.redact(fmt.Sprintf(format, args...)))
+}
+
+func (r *redactingLogger) redact(message string) string {
+	for _, secret := range r.secrets {
+		message = strings.ReplaceAll(message, secret, redactedValue)
+	}
+	for _, expression := range redactExpressions {
+		message = expression.ReplaceAllString(message, "${1}"+redactedValue)
+	}
+	return message
+}
+
+// newResourceLogger creates a logger that prefixes each message with the resource type, the operation, and the ID
+// of the resource the operation is running on.
+func newResourceLogger(
+	backend ovirtclientlog.Logger,
+	resourceType string,
+	operation string,
+	id string,
+) ovirtclientlog.Logger {
+	if id == "" {
+		id = "new"
+	}
+	return &resourceLogger{
+		backend: backend,
+		prefix:  fmt.Sprintf("%s %s (id: %s): ", resourceType, operation, id),
+	}
+}
+
+type resourceLogger struct {
+	backend ovirtclientlog.Logger
+	prefix  string
+}
+
+func (r *resourceLogger) Debugf(format string, args ...interface{}) {
+	r.backend.Debugf(r.prefix+format, args...)
+}
+
+func (r *resourceLogger) Infof(format string, args ...interface{}) {
+	r.backend.Infof(r.prefix+format, args...)
+}
+
+func (r *resourceLogger) Warningf(format string, args ...interface{}) {
+	r.backend.Warningf(r.prefix+format, args...)
+}
+
+func (r *resourceLogger) Errorf(format string, args ...interface{}) {
+	r.backend.Errorf(r.prefix+format, args...)
+}
+
+// logResourceOperations wraps the create, read, update, delete, and import functions of a resource so that the start
+// and the outcome of each operation is logged with the resource type, the operation, and the resource ID.
+//
+// The API calls logged by go-ovirt-client itself do not carry this context. The client is created once per provider
+// configuration and shared between resource operations Terraform runs in parallel, so its log messages cannot be
+// attributed to a single resource.
+func (p *provider) logResourceOperations(resourceType string, resource *schema.Resource) *schema.Resource {
+	resource.CreateContext = p.logResourceOperation(resourceType, "create", resource.CreateContext)
+	resource.ReadContext = p.logResourceOperation(resourceType, "read", resource.ReadContext)
+	resource.UpdateContext = p.logResourceOperation(resourceType, "update", resource.UpdateContext)
+	resource.DeleteContext = p.logResourceOperation(resourceType, "delete", resource.DeleteContext)
+	if resource.Importer != nil {
+		resource.Importer.StateContext = p.logResourceImport(resourceType, resource.Importer.StateContext)
+	}
+	return resource
+}
+
+func (p *provider) logResourceOperation(
+	resourceType string,
+	operation string,
+	f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics,
+) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	if f == nil {
+		return nil
+	}
+	return func(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		logger := newResourceLogger(p.logger, resourceType, operation, data.Id())
+		logger.Debugf("starting...")
+		diags := f(ctx, data, meta)
+		for _, d := range diags {
+			switch d.Severity {
+			case diag.Error:
+				logger.Errorf("%s (%s)", d.Summary, d.Detail)
+			case diag.Warning:
+				logger.Warningf("%s (%s)", d.Summary, d.Detail)
+			}
+		}
+		if diags.HasError() {
+			logger.Debugf("failed.")
+		} else {
+			logger.Debugf("completed, resource ID is now %q.", data.Id())
+		}
+		return diags
+	}
+}
+
+func (p *provider) logResourceImport(
+	resourceType string,
+	f schema.StateContextFunc,
+) schema.StateContextFunc {
+	if f == nil {
+		return nil
+	}
+	return func(ctx context.Context, data *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		logger := newResourceLogger(p.logger, resourceType, "import", data.Id())
+		logger.Debugf("starting...")
+		result, err := f(ctx, data, meta)
+		if err != nil {
+			logger.Errorf("%v", err)
+			logger.Debugf("failed.")
+		} else {
+			logger.Debugf("completed, resource ID is now %q.", data.Id())
+		}
+		return result, err
+	}
+}
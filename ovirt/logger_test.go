@@ -0,0 +1,140 @@
+package ovirt
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+type recordingLogger struct {
+	messages []string
+}
+
+func (r *recordingLogger) Debugf(format string, args ...interface{}) {
+	r.messages = append(r.messages, "DEBUG "+fmt.Sprintf(format, args...))
+}
+
+func (r *recordingLogger) Infof(format string, args ...interface{}) {
+	r.messages = append(r.messages, "INFO "+fmt.Sprintf(format, args...))
+}
+
+func (r *recordingLogger) Warningf(format string, args ...interface{}) {
+	r.messages = append(r.messages, "WARN "+fmt.Sprintf(format, args...))
+}
+
+func (r *recordingLogger) Errorf(format string, args ...interface{}) {
+	r.messages = append(r.messages, "ERROR "+fmt.Sprintf(format, args...))
+}
+
+func TestRedactingLogger(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		message  string
+		secrets  []string
+		expected string
+	}{
+		{
+			name:     "configured password",
+			message:  "Authenticating admin@internal with hunter2...",
+			secrets:  []string{"hunter2"},
+			expected: "Authenticating admin@internal with ***...",
+		},
+		{
+			name:     "password field",
+			message:  "Sending grant_type=password&username=admin&password=hunter2",
+			expected: "Sending grant_type=password&username=admin&password=***",
+		},
+		{
+			name:     "JSON token",
+			message:  `Received {"access_token":"abcdef", "expires_in": 3600}`,
+			expected: `Received {"access_token":"***", "expires_in": 3600}`,
+		},
+		{
+			name:     "authorization header",
+			message:  "Authorization: Bearer abcdef",
+			expected: "Authorization: Bearer ***",
+		},
+		{
+			name:     "no secrets",
+			message:  "Creating VM test...",
+			expected: "Creating VM test...",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+			backend := &recordingLogger{}
+			logger := newRedactingLogger(backend, testCase.secrets...)
+			logger.Infof("%s", testCase.message)
+			if len(backend.messages) != 1 {
+				t.Fatalf("unexpected number of log messages: %d", len(backend.messages))
+			}
+			if backend.messages[0] != "INFO "+testCase.expected {
+				t.Fatalf("incorrect redacted message: %s, expected: INFO %s", backend.messages[0], testCase.expected)
+			}
+		})
+	}
+}
+
+func TestRedactingLoggerMergesSecrets(t *testing.T) {
+	t.Parallel()
+
+	backend := &recordingLogger{}
+	logger := newRedactingLogger(newRedactingLogger(backend, "hunter2"), "correcthorse")
+	if redacting, ok := logger.(*redactingLogger); !ok || redacting.backend != backend {
+		t.Fatalf("the redacting logger wraps another redacting logger")
+	}
+
+	logger.Infof("Trying hunter2 and correcthorse...")
+	if len(backend.messages) != 1 {
+		t.Fatalf("unexpected number of log messages: %d", len(backend.messages))
+	}
+	if backend.messages[0] != "INFO Trying *** and ***..." {
+		t.Fatalf("incorrect redacted message: %s", backend.messages[0])
+	}
+}
+
+func TestResourceLogger(t *testing.T) {
+	t.Parallel()
+
+	backend := &recordingLogger{}
+	logger := newResourceLogger(backend, "ovirt_vm", "delete", "asdf")
+	logger.Warningf("VM %s is still running", "test")
+
+	if len(backend.messages) != 1 {
+		t.Fatalf("unexpected number of log messages: %d", len(backend.messages))
+	}
+	for _, expected := range []string{"WARN ", "ovirt_vm", "delete", "asdf", "VM test is still running"} {
+		if !strings.Contains(backend.messages[0], expected) {
+			t.Fatalf("log message %q does not contain %q", backend.messages[0], expected)
+		}
+	}
+}
+
+func TestLogResourceImport(t *testing.T) {
+	t.Parallel()
+
+	backend := &recordingLogger{}
+	p := newProvider(backend)
+	client := p.getTestHelper().GetClient()
+	vmResource := p.getProvider().ResourcesMap["ovirt_vm"]
+
+	data := vmResource.TestResourceData()
+	data.SetId("00000000-0000-0000-0000-000000000001")
+	if _, err := vmResource.Importer.StateContext(context.Background(), data, client); err == nil {
+		t.Fatalf("importing a nonexistent VM did not result in an error")
+	}
+
+	for _, message := range backend.messages {
+		if strings.HasPrefix(message, "ERROR ") &&
+			strings.Contains(message, "ovirt_vm import (id: 00000000-0000-0000-0000-000000000001)") {
+			return
+		}
+	}
+	t.Fatalf("the failed import was not logged with the resource context: %v", backend.messages)
+}
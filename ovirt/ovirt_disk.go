@@ -82,8 +82,9 @@ func (p *provider) diskResource() *schema.Resource {
 func (p *provider) diskCreate(
 	ctx context.Context,
 	data *schema.ResourceData,
-	_ interface{},
+	meta interface{},
 ) diag.Diagnostics {
+	client := meta.(ovirtclient.Client)
 	var err error
 
 	storageDomainID := data.Get("storagedomain_id").(string)
@@ -116,7 +117,7 @@ func (p *provider) diskCreate(
 		}
 	}
 
-	disk, err := p.client.CreateDisk(
+	disk, err := client.CreateDisk(
 		storageDomainID,
 		ovirtclient.ImageFormat(format),
 		uint64(size),
@@ -149,9 +150,14 @@ func diskResourceUpdate(disk ovirtclient.Disk, data *schema.ResourceData) diag.D
 	return diags
 }
 
-func (p *provider) diskRead(ctx context.Context, data *schema.ResourceData, _ interface{}) diag.Diagnostics {
-	disk, err := p.client.GetDisk(data.Id(), ovirtclient.ContextStrategy(ctx))
+func (p *provider) diskRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(ovirtclient.Client)
+	disk, err := client.GetDisk(data.Id(), ovirtclient.ContextStrategy(ctx))
 	if err != nil {
+		if isNotFound(err) {
+			data.SetId("")
+			return nil
+		}
 		return diag.Diagnostics{
 			diag.Diagnostic{
 				Severity: diag.Error,
@@ -163,7 +169,8 @@ func (p *provider) diskRead(ctx context.Context, data *schema.ResourceData, _ in
 	return diskResourceUpdate(disk, data)
 }
 
-func (p *provider) diskUpdate(ctx context.Context, data *schema.ResourceData, _ interface{}) diag.Diagnostics {
+func (p *provider) diskUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(ovirtclient.Client)
 	params := ovirtclient.UpdateDiskParams()
 	var err error
 	if alias, ok := data.GetOk("alias"); ok {
@@ -178,7 +185,7 @@ func (p *provider) diskUpdate(ctx context.Context, data *schema.ResourceData, _
 			}
 		}
 	}
-	disk, err := p.client.UpdateDisk(data.Id(), params, ovirtclient.ContextStrategy(ctx))
+	disk, err := client.UpdateDisk(data.Id(), params, ovirtclient.ContextStrategy(ctx))
 	if err != nil {
 		return diag.Diagnostics{
 			diag.Diagnostic{
@@ -191,8 +198,9 @@ func (p *provider) diskUpdate(ctx context.Context, data *schema.ResourceData, _
 	return diskResourceUpdate(disk, data)
 }
 
-func (p *provider) diskDelete(ctx context.Context, data *schema.ResourceData, _ interface{}) diag.Diagnostics {
-	if err := p.client.RemoveDisk(data.Id(), ovirtclient.ContextStrategy(ctx)); err != nil {
+func (p *provider) diskDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(ovirtclient.Client)
+	if err := client.RemoveDisk(data.Id(), ovirtclient.ContextStrategy(ctx)); err != nil {
 		if isNotFound(err) {
 			data.SetId("")
 			return nil
@@ -209,11 +217,12 @@ func (p *provider) diskDelete(ctx context.Context, data *schema.ResourceData, _
 	return nil
 }
 
-func (p *provider) diskImport(ctx context.Context, data *schema.ResourceData, _ interface{}) (
+func (p *provider) diskImport(ctx context.Context, data *schema.ResourceData, meta interface{}) (
 	[]*schema.ResourceData,
 	error,
 ) {
-	disk, err := p.client.GetDisk(data.Id(), ovirtclient.ContextStrategy(ctx))
+	client := meta.(ovirtclient.Client)
+	disk, err := client.GetDisk(data.Id(), ovirtclient.ContextStrategy(ctx))
 	if err != nil {
 		return nil, fmt.Errorf("failed to import disk %s (%w)", data.Id(), err)
 	}
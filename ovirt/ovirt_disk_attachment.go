@@ -59,13 +59,14 @@ func (p *provider) diskAttachmentResource() *schema.Resource {
 func (p *provider) diskAttachmentCreate(
 	ctx context.Context,
 	data *schema.ResourceData,
-	_ interface{},
+	meta interface{},
 ) diag.Diagnostics {
+	client := meta.(ovirtclient.Client)
 	vmID := data.Get("vm_id").(string)
 	diskID := data.Get("disk_id").(string)
 	diskInterface := data.Get("disk_interface").(string)
 
-	diskAttachment, err := p.client.CreateDiskAttachment(
+	diskAttachment, err := client.CreateDiskAttachment(
 		vmID,
 		diskID,
 		ovirtclient.DiskInterface(diskInterface),
@@ -83,12 +84,16 @@ func (p *provider) diskAttachmentCreate(
 	return diskAttachmentResourceUpdate(diskAttachment, data)
 }
 
-func (p *provider) diskAttachmentRead(ctx context.Context, data *schema.ResourceData, _ interface{}) diag.Diagnostics {
+func (p *provider) diskAttachmentRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(ovirtclient.Client)
 	vmID := data.Get("vm_id").(string)
-	attachment, err := p.client.GetDiskAttachment(vmID, data.Id(), ovirtclient.ContextStrategy(ctx))
-	if isNotFound(err) {
-		data.SetId("")
-		return nil
+	attachment, err := client.GetDiskAttachment(vmID, data.Id(), ovirtclient.ContextStrategy(ctx))
+	if err != nil {
+		if isNotFound(err) {
+			data.SetId("")
+			return nil
+		}
+		return errorToDiags("fetch disk attachment", err)
 	}
 	return diskAttachmentResourceUpdate(attachment, data)
 }
@@ -96,10 +101,11 @@ func (p *provider) diskAttachmentRead(ctx context.Context, data *schema.Resource
 func (p *provider) diskAttachmentDelete(
 	ctx context.Context,
 	data *schema.ResourceData,
-	_ interface{},
+	meta interface{},
 ) diag.Diagnostics {
+	client := meta.(ovirtclient.Client)
 	vmID := data.Get("vm_id").(string)
-	if err := p.client.RemoveDiskAttachment(vmID, data.Id(), ovirtclient.ContextStrategy(ctx)); err != nil {
+	if err := client.RemoveDiskAttachment(vmID, data.Id(), ovirtclient.ContextStrategy(ctx)); err != nil {
 		if isNotFound(err) {
 			data.SetId("")
 			return nil
@@ -117,8 +123,9 @@ func (p *provider) diskAttachmentDelete(
 func (p *provider) diskAttachmentImport(
 	ctx context.Context,
 	data *schema.ResourceData,
-	_ interface{},
+	meta interface{},
 ) ([]*schema.ResourceData, error) {
+	client := meta.(ovirtclient.Client)
 	importID := data.Id()
 
 	parts := strings.SplitN(importID, "/", 2)
@@ -127,7 +134,7 @@ func (p *provider) diskAttachmentImport(
 			"invalid import specification, the ID should be specified as: VMID/DiskAttachmentID",
 		)
 	}
-	attachment, err := p.client.GetDiskAttachment(parts[0], parts[1], ovirtclient.ContextStrategy(ctx))
+	attachment, err := client.GetDiskAttachment(parts[0], parts[1], ovirtclient.ContextStrategy(ctx))
 	if isNotFound(err) {
 		return nil, fmt.Errorf("disk attachment with the specified VMID/ID %s not found (%w)", importID, err)
 	}
@@ -135,20 +142,17 @@ func (p *provider) diskAttachmentImport(
 		return nil, fmt.Errorf("failed to import disk_attachment %s (%w)", importID, err)
 	}
 
-	data.SetId(attachment.ID())
-	if err := data.Set("vm_id", attachment.VMID()); err != nil {
-		return nil, fmt.Errorf("failed to set vm_id to %s", attachment.VMID())
-	}
-	if err := data.Set("disk_id", attachment.DiskID()); err != nil {
-		return nil, fmt.Errorf("failed to set disk_id to %s", attachment.DiskID())
-	}
-	if err := data.Set("disk_interface", string(attachment.DiskInterface())); err != nil {
-		return nil, fmt.Errorf("failed to set disk_interface to %s", attachment.DiskInterface())
+	if err := diagsToError(diskAttachmentResourceUpdate(attachment, data)); err != nil {
+		return nil, fmt.Errorf("failed to import disk_attachment %s (%w)", importID, err)
 	}
 	return []*schema.ResourceData{data}, nil
 }
 
-func diskAttachmentResourceUpdate(disk ovirtclient.DiskAttachment, data *schema.ResourceData) diag.Diagnostics {
-	data.SetId(disk.ID())
-	return nil
+func diskAttachmentResourceUpdate(attachment ovirtclient.DiskAttachment, data *schema.ResourceData) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	data.SetId(attachment.ID())
+	diags = setResourceField(data, "vm_id", attachment.VMID(), diags)
+	diags = setResourceField(data, "disk_id", attachment.DiskID(), diags)
+	diags = setResourceField(data, "disk_interface", string(attachment.DiskInterface()), diags)
+	return diags
 }
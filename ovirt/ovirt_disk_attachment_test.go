@@ -1,11 +1,13 @@
 package ovirt
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	ovirtclient "github.com/ovirt/go-ovirt-client"
 	ovirtclientlog "github.com/ovirt/go-ovirt-client-log/v2"
@@ -162,3 +164,89 @@ resource "ovirt_disk_attachment" "test" {
 		},
 	)
 }
+
+// createTestDiskAttachment creates a VM and a disk in the mock and attaches the disk to the VM, bypassing Terraform.
+func createTestDiskAttachment(t *testing.T, helper ovirtclient.TestHelper) ovirtclient.DiskAttachment {
+	client := helper.GetClient()
+	vm, err := client.CreateVM(helper.GetClusterID(), helper.GetBlankTemplateID(), nil)
+	if err != nil {
+		t.Fatalf("failed to create test VM (%v)", err)
+	}
+	disk, err := client.CreateDisk(helper.GetStorageDomainID(), ovirtclient.ImageFormatRaw, 512, nil)
+	if err != nil {
+		t.Fatalf("failed to create test disk (%v)", err)
+	}
+	attachment, err := client.CreateDiskAttachment(vm.ID(), disk.ID(), ovirtclient.DiskInterfaceVirtIOSCSI, nil)
+	if err != nil {
+		t.Fatalf("failed to create test disk attachment (%v)", err)
+	}
+	return attachment
+}
+
+// TestDiskAttachmentReadOverwritesState checks that read replaces the state with the values from the engine. The client
+// cannot change a disk attachment in place, so instead of modifying the mock the test starts from a state that does not
+// match the engine.
+func TestDiskAttachmentReadOverwritesState(t *testing.T) {
+	t.Parallel()
+
+	p := newProvider(ovirtclientlog.NewTestLogger(t))
+	helper := p.getTestHelper()
+	attachment := createTestDiskAttachment(t, helper)
+
+	// The state holds a different disk and interface than what is attached in the engine.
+	data := schema.TestResourceDataRaw(
+		t, diskAttachmentSchema, map[string]interface{}{
+			"vm_id":          attachment.VMID(),
+			"disk_id":        "00000000-0000-0000-0000-000000000001",
+			"disk_interface": string(ovirtclient.DiskInterfaceIDE),
+		},
+	)
+	data.SetId(attachment.ID())
+
+	if diags := p.(*provider).diskAttachmentRead(context.Background(), data, helper.GetClient()); diags.HasError() {
+		t.Fatalf("failed to read disk attachment (%v)", diagsToError(diags))
+	}
+	if data.Id() != attachment.ID() {
+		t.Fatalf("incorrect disk attachment ID after read: %s", data.Id())
+	}
+	if diskID := data.Get("disk_id").(string); diskID != attachment.DiskID() {
+		t.Fatalf("disk_id was not refreshed, got %s instead of %s", diskID, attachment.DiskID())
+	}
+	if diskInterface := data.Get("disk_interface").(string); diskInterface != string(attachment.DiskInterface()) {
+		t.Fatalf(
+			"disk_interface was not refreshed, got %s instead of %s",
+			diskInterface,
+			attachment.DiskInterface(),
+		)
+	}
+}
+
+func TestDiskAttachmentReadRemoved(t *testing.T) {
+	t.Parallel()
+
+	p := newProvider(ovirtclientlog.NewTestLogger(t))
+	helper := p.getTestHelper()
+	client := helper.GetClient()
+	attachment := createTestDiskAttachment(t, helper)
+
+	data := schema.TestResourceDataRaw(
+		t, diskAttachmentSchema, map[string]interface{}{
+			"vm_id":          attachment.VMID(),
+			"disk_id":        attachment.DiskID(),
+			"disk_interface": string(attachment.DiskInterface()),
+		},
+	)
+	data.SetId(attachment.ID())
+
+	// Detach the disk behind Terraform's back.
+	if err := client.RemoveDiskAttachment(attachment.VMID(), attachment.ID()); err != nil {
+		t.Fatalf("failed to remove test disk attachment (%v)", err)
+	}
+
+	if diags := p.(*provider).diskAttachmentRead(context.Background(), data, client); diags.HasError() {
+		t.Fatalf("failed to read disk attachment (%v)", diagsToError(diags))
+	}
+	if data.Id() != "" {
+		t.Fatalf("the removed disk attachment was not removed from the state")
+	}
+}
@@ -91,15 +91,16 @@ func (p *provider) diskAttachmentsResource() *schema.Resource {
 func (p *provider) diskAttachmentsCreateOrUpdate(
 	ctx context.Context,
 	data *schema.ResourceData,
-	_ interface{},
+	meta interface{},
 ) diag.Diagnostics {
+	client := meta.(ovirtclient.Client)
 	vmID := data.Get("vm_id").(string)
 	desiredAttachments := data.Get("attachment").(*schema.Set)
 	detachUnmanaged := data.Get("detach_unmanaged").(bool)
 	removeUnmanaged := data.Get("remove_unmanaged").(bool)
 	retry := ovirtclient.ContextStrategy(ctx)
 
-	existingAttachments, err := p.client.ListDiskAttachments(vmID, retry)
+	existingAttachments, err := client.ListDiskAttachments(vmID, retry)
 	if err != nil {
 		return errorToDiags("list existing disk attachments", err)
 	}
@@ -109,6 +110,7 @@ func (p *provider) diskAttachmentsCreateOrUpdate(
 		desiredAttachment := desiredAttachmentInterface.(map[string]interface{})
 		diags = append(
 			diags, p.createOrUpdateDiskAttachment(
+				client,
 				existingAttachments,
 				desiredAttachment,
 				vmID,
@@ -118,7 +120,14 @@ func (p *provider) diskAttachmentsCreateOrUpdate(
 	}
 
 	if detachUnmanaged || removeUnmanaged {
-		diags = p.cleanUnmanagedDiskAttachments(removeUnmanaged, existingAttachments, desiredAttachments, retry, diags)
+		diags = p.cleanUnmanagedDiskAttachments(
+			client,
+			removeUnmanaged,
+			existingAttachments,
+			desiredAttachments,
+			retry,
+			diags,
+		)
 	}
 	data.SetId(vmID)
 	if err := data.Set("attachment", desiredAttachments); err != nil {
@@ -128,6 +137,7 @@ func (p *provider) diskAttachmentsCreateOrUpdate(
 }
 
 func (p *provider) cleanUnmanagedDiskAttachments(
+	client ovirtclient.Client,
 	removeUnmanaged bool,
 	existingAttachments []ovirtclient.DiskAttachment,
 	desiredAttachments *schema.Set,
@@ -153,7 +163,7 @@ func (p *provider) cleanUnmanagedDiskAttachments(
 					),
 				)
 			} else if removeUnmanaged {
-				if err := p.client.RemoveDisk(attachment.DiskID(), retry); err != nil {
+				if err := client.RemoveDisk(attachment.DiskID(), retry); err != nil {
 					diags = append(
 						diags,
 						errorToDiag(
@@ -172,6 +182,7 @@ func (p *provider) cleanUnmanagedDiskAttachments(
 // the attachment. If none is found, or the ID is not set, it will create the attachment. If the disk interface type
 // is mismatched, the attachment will be recreated with the correct type.
 func (p *provider) createOrUpdateDiskAttachment(
+	client ovirtclient.Client,
 	existingAttachments []ovirtclient.DiskAttachment,
 	desiredAttachment map[string]interface{},
 	vmID string,
@@ -211,7 +222,7 @@ func (p *provider) createOrUpdateDiskAttachment(
 	}
 
 	// Create or re-create disk attachment, then set it in the Terraform state.
-	attachment, err := p.client.CreateDiskAttachment(
+	attachment, err := client.CreateDiskAttachment(
 		vmID,
 		diskID,
 		ovirtclient.DiskInterface(diskInterfaceName),
@@ -233,10 +244,11 @@ func (p *provider) createOrUpdateDiskAttachment(
 func (p *provider) diskAttachmentsRead(
 	ctx context.Context,
 	data *schema.ResourceData,
-	_ interface{},
+	meta interface{},
 ) diag.Diagnostics {
+	client := meta.(ovirtclient.Client)
 	vmID := data.Get("vm_id").(string)
-	diskAttachments, err := p.client.ListDiskAttachments(vmID, ovirtclient.ContextStrategy(ctx))
+	diskAttachments, err := client.ListDiskAttachments(vmID, ovirtclient.ContextStrategy(ctx))
 	if err != nil {
 		return errorToDiags(fmt.Sprintf("listing disk attachments of VM %s", vmID), err)
 	}
@@ -287,14 +299,15 @@ func (p *provider) diskAttachmentsRead(
 func (p *provider) diskAttachmentsDelete(
 	ctx context.Context,
 	data *schema.ResourceData,
-	_ interface{},
+	meta interface{},
 ) diag.Diagnostics {
+	client := meta.(ovirtclient.Client)
 	diags := diag.Diagnostics{}
 	vmID := data.Get("vm_id").(string)
 	attachments := data.Get("attachment").(*schema.Set)
 	for _, attachmentInterface := range attachments.List() {
 		attachment := attachmentInterface.(map[string]interface{})
-		if err := p.client.RemoveDiskAttachment(
+		if err := client.RemoveDiskAttachment(
 			vmID,
 			attachment["id"].(string),
 			ovirtclient.ContextStrategy(ctx),
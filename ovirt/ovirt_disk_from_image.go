@@ -0,0 +1,320 @@
+package ovirt
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ovirtclient "github.com/ovirt/go-ovirt-client"
+	ovirtclientlog "github.com/ovirt/go-ovirt-client-log/v2"
+)
+
+// uploadProgressInterval is the interval in which the progress of an image upload is logged.
+const uploadProgressInterval = 10 * time.Second
+
+// diskFromImageCreateTimeout is the default time the upload may take. Large images may need a longer timeout, which
+// can be set in the timeouts block of the resource.
+const diskFromImageCreateTimeout = 60 * time.Minute
+
+var diskFromImageSchema = map[string]*schema.Schema{
+	"id": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"storagedomain_id": {
+		Type:             schema.TypeString,
+		Required:         true,
+		Description:      "ID of the storage domain to upload the image to.",
+		ForceNew:         true,
+		ValidateDiagFunc: validateUUID,
+	},
+	"source_file": {
+		Type:             schema.TypeString,
+		Required:         true,
+		Description:      "Path to the local image file to upload. QCOW2, raw and ISO images are supported.",
+		ForceNew:         true,
+		ValidateDiagFunc: validateNonEmpty,
+	},
+	"source_file_sha256": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Description:      "Expected SHA-256 checksum of the source file in hexadecimal form. If set, the upload fails if the source file does not match.",
+		ForceNew:         true,
+		ValidateDiagFunc: validateSHA256,
+	},
+	"sha256": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		ForceNew:    true,
+		Description: "SHA-256 checksum of the uploaded source file. The disk is replaced when the checksum of the source file changes.",
+	},
+	"format": {
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+		Description: fmt.Sprintf(
+			"Format for the disk. One of: `%s`. Defaults to the format of the source file. The oVirt Engine converts the image if the format differs.",
+			strings.Join(ovirtclient.ImageFormatValues().Strings(), "`, `"),
+		),
+		ValidateDiagFunc: validateFormat,
+		ForceNew:         true,
+	},
+	"alias": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Computed:    true,
+		Description: "Human-readable alias for the disk.",
+	},
+	"sparse": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Computed:    true,
+		ForceNew:    true,
+		Description: "Use sparse provisioning for disk. Defaults to the setting of the oVirt Engine.",
+	},
+	"size": {
+		Type:        schema.TypeInt,
+		Computed:    true,
+		Description: "Disk size in bytes as detected from the image.",
+	},
+	"total_size": {
+		Type:        schema.TypeInt,
+		Computed:    true,
+		Description: "Size of the actual image size on the disk in bytes.",
+	},
+	"status": {
+		Type:     schema.TypeString,
+		Computed: true,
+		Description: fmt.Sprintf(
+			"Status of the disk. One of: `%s`.",
+			strings.Join(ovirtclient.DiskStatusValues().Strings(), "`, `"),
+		),
+	},
+}
+
+func (p *provider) diskFromImageResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: p.diskFromImageCreate,
+		ReadContext:   p.diskRead,
+		UpdateContext: p.diskUpdate,
+		DeleteContext: p.diskDelete,
+		CustomizeDiff: diskFromImageCustomizeDiff,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(diskFromImageCreateTimeout),
+		},
+		Schema: diskFromImageSchema,
+		Description: `The ovirt_disk_from_image resource creates disks in oVirt by uploading a local image file. The disk is replaced when the contents of the image file change.
+
+-> The upload must finish within 60 minutes by default. For large images, increase the limit using the create option of the timeouts block.`,
+	}
+}
+
+// diskFromImageCustomizeDiff calculates the checksum of the source file during planning so that a changed source
+// file results in the disk being replaced.
+func diskFromImageCustomizeDiff(_ context.Context, data *schema.ResourceDiff, _ interface{}) error {
+	if !data.NewValueKnown("source_file") {
+		return nil
+	}
+	checksum, err := fileSHA256(data.Get("source_file").(string))
+	if err != nil {
+		if os.IsNotExist(err) && data.Id() != "" {
+			// The source file may have been removed after the upload. We keep the existing disk in this case.
+			return nil
+		}
+		return err
+	}
+	if expected, ok := data.GetOk("source_file_sha256"); ok && !strings.EqualFold(expected.(string), checksum) {
+		return fmt.Errorf(
+			"the checksum of the source file (%s) does not match the source_file_sha256 option (%s)",
+			checksum,
+			expected,
+		)
+	}
+	if data.Get("sha256").(string) != checksum {
+		return data.SetNew("sha256", checksum)
+	}
+	return nil
+}
+
+func (p *provider) diskFromImageCreate(
+	ctx context.Context,
+	data *schema.ResourceData,
+	meta interface{},
+) diag.Diagnostics {
+	client := meta.(ovirtclient.Client)
+	logger := newResourceLogger(p.logger, "ovirt_disk_from_image", "create", "")
+
+	storageDomainID := data.Get("storagedomain_id").(string)
+	sourceFile := data.Get("source_file").(string)
+
+	checksum, err := fileSHA256(sourceFile)
+	if err != nil {
+		return errorToDiags("calculate the checksum of the source file", err)
+	}
+	if plannedChecksum := data.Get("sha256").(string); plannedChecksum != "" && plannedChecksum != checksum {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Source file changed.",
+				Detail: fmt.Sprintf(
+					"The checksum of %s changed from %s to %s since the plan was created.",
+					sourceFile,
+					plannedChecksum,
+					checksum,
+				),
+			},
+		}
+	}
+
+	fh, err := os.Open(filepath.Clean(sourceFile))
+	if err != nil {
+		return errorToDiags("open source file", err)
+	}
+	defer func() {
+		_ = fh.Close()
+	}()
+	stat, err := fh.Stat()
+	if err != nil {
+		return errorToDiags("stat source file", err)
+	}
+	// An empty format makes the client create the disk in the format of the image.
+	var format ovirtclient.ImageFormat
+	if f, ok := data.GetOk("format"); ok {
+		format = ovirtclient.ImageFormat(f.(string))
+	}
+
+	params, diags := diskFromImageCreateParams(data)
+	if diags.HasError() {
+		return diags
+	}
+
+	logger.Infof("uploading %s (%d bytes)...", sourceFile, stat.Size())
+	progress, err := client.StartUploadToNewDisk(
+		storageDomainID,
+		format,
+		uint64(stat.Size()),
+		params,
+		fh,
+		ovirtclient.ContextStrategy(ctx),
+	)
+	if err != nil {
+		return errorToDiags("start image upload", err)
+	}
+	if err := waitForUpload(ctx, progress, fh, logger); err != nil {
+		removeFailedUpload(client, progress.Disk(), logger)
+		return errorToDiags("upload image", err)
+	}
+	logger.Infof("upload of %s complete.", sourceFile)
+
+	diags = diskResourceUpdate(progress.Disk(), data)
+	diags = setResourceField(data, "sha256", checksum, diags)
+	return diags
+}
+
+// diskFromImageCreateParams returns the optional disk parameters set in the configuration.
+func diskFromImageCreateParams(data *schema.ResourceData) (ovirtclient.BuildableCreateDiskParameters, diag.Diagnostics) {
+	params := ovirtclient.CreateDiskParams()
+	var err error
+	if alias, ok := data.GetOk("alias"); ok {
+		params, err = params.WithAlias(alias.(string))
+		if err != nil {
+			return nil, diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "Invalid alias value.",
+					Detail:   err.Error(),
+				},
+			}
+		}
+	}
+	// GetOk reports false values as unset, so an explicit sparse = false would be replaced by the engine default.
+	if sparse, ok := data.GetOkExists("sparse"); ok { //nolint:staticcheck
+		params, err = params.WithSparse(sparse.(bool))
+		if err != nil {
+			return nil, diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "Invalid sparse value.",
+					Detail:   err.Error(),
+				},
+			}
+		}
+	}
+	return params, nil
+}
+
+// waitForUpload waits for the upload to finish and logs the progress periodically. When the context is cancelled,
+// the source is closed to abort the upload and the function waits until the client has stopped the upload in the
+// background.
+func waitForUpload(
+	ctx context.Context,
+	progress ovirtclient.UploadImageProgress,
+	source io.Closer,
+	logger ovirtclientlog.Logger,
+) error {
+	ticker := time.NewTicker(uploadProgressInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-progress.Done():
+			return progress.Err()
+		case <-ticker.C:
+			uploaded := progress.UploadedBytes()
+			total := progress.TotalBytes()
+			percent := uint64(0)
+			if total > 0 {
+				percent = uploaded * 100 / total
+			}
+			logger.Infof("uploaded %d of %d bytes (%d%%)...", uploaded, total, percent)
+		case <-ctx.Done():
+			logger.Warningf("timeout while waiting for the image upload to finish, aborting upload...")
+			_ = source.Close()
+			<-progress.Done()
+			if progress.Err() == nil {
+				// The upload finished before it could be aborted.
+				return nil
+			}
+			return fmt.Errorf("timeout while waiting for the image upload to finish (%w)", ctx.Err())
+		}
+	}
+}
+
+// removeFailedUpload removes the disk created for a failed upload. The client already attempts this itself, but it
+// uses the retry strategy of the upload, which fails immediately after a timeout as the context is already cancelled.
+// The default retries of the client are used here instead.
+func removeFailedUpload(client ovirtclient.Client, disk ovirtclient.Disk, logger ovirtclientlog.Logger) {
+	if disk == nil {
+		return
+	}
+	if err := client.RemoveDisk(disk.ID()); err != nil && !isNotFound(err) {
+		logger.Warningf(
+			"failed to remove disk %s after the failed upload, please remove it manually (%v)",
+			disk.ID(),
+			err,
+		)
+	}
+}
+
+// fileSHA256 returns the hex-encoded SHA-256 checksum of the specified file.
+func fileSHA256(file string) (string, error) {
+	fh, err := os.Open(filepath.Clean(file))
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		_ = fh.Close()
+	}()
+	hash := sha256.New()
+	if _, err := io.Copy(hash, fh); err != nil {
+		return "", fmt.Errorf("failed to read %s (%w)", file, err)
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
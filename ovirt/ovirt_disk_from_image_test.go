@@ -0,0 +1,353 @@
+package ovirt
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	ovirtclient "github.com/ovirt/go-ovirt-client"
+	ovirtclientlog "github.com/ovirt/go-ovirt-client-log/v2"
+)
+
+// writeTestImage writes a raw test image to a temporary directory and returns its path and SHA-256 checksum.
+func writeTestImage(t *testing.T) (string, string) {
+	image := bytes.Repeat([]byte{0}, 1024*1024)
+	file := filepath.Join(t.TempDir(), "image.raw")
+	if err := os.WriteFile(file, image, 0600); err != nil {
+		t.Fatalf("failed to write test image (%v)", err)
+	}
+	checksum := sha256.Sum256(image)
+	return file, hex.EncodeToString(checksum[:])
+}
+
+func TestDiskFromImageResource(t *testing.T) {
+	t.Parallel()
+
+	p := newProvider(ovirtclientlog.NewTestLogger(t))
+	storageDomainID := p.getTestHelper().GetStorageDomainID()
+	file, checksum := writeTestImage(t)
+	diskID := ""
+
+	// The mock cannot detect the image format, so the tests always specify it.
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: p.getProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(
+					`
+provider "ovirt" {
+	mock = true
+}
+
+resource "ovirt_disk_from_image" "foo" {
+	storagedomain_id   = "%s"
+	source_file        = "%s"
+	source_file_sha256 = "%s"
+	format             = "raw"
+	alias              = "test"
+	sparse             = true
+}
+`,
+					storageDomainID,
+					file,
+					checksum,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(
+						"ovirt_disk_from_image.foo",
+						"format",
+						regexp.MustCompile("^raw$"),
+					),
+					resource.TestMatchResourceAttr(
+						"ovirt_disk_from_image.foo",
+						"sha256",
+						regexp.MustCompile(fmt.Sprintf("^%s$", checksum)),
+					),
+					func(state *terraform.State) error {
+						diskID = state.RootModule().Resources["ovirt_disk_from_image.foo"].Primary.ID
+						return nil
+					},
+				),
+			},
+			{
+				// Leaving out sparse and alias must keep the values reported by the engine instead of replacing
+				// the disk.
+				Config: fmt.Sprintf(
+					`
+provider "ovirt" {
+	mock = true
+}
+
+resource "ovirt_disk_from_image" "foo" {
+	storagedomain_id = "%s"
+	source_file      = "%s"
+	format           = "raw"
+}
+`,
+					storageDomainID,
+					file,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(
+						"ovirt_disk_from_image.foo",
+						"sparse",
+						regexp.MustCompile("^true$"),
+					),
+					func(state *terraform.State) error {
+						newDiskID := state.RootModule().Resources["ovirt_disk_from_image.foo"].Primary.ID
+						if newDiskID != diskID {
+							return fmt.Errorf("the disk was replaced (old ID: %s, new ID: %s)", diskID, newDiskID)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestDiskFromImageCreate(t *testing.T) {
+	t.Parallel()
+
+	p := newProvider(ovirtclientlog.NewTestLogger(t))
+	client := p.getTestHelper().GetClient()
+	storageDomainID := p.getTestHelper().GetStorageDomainID()
+	file, checksum := writeTestImage(t)
+
+	data := schema.TestResourceDataRaw(
+		t, diskFromImageSchema, map[string]interface{}{
+			"storagedomain_id": storageDomainID,
+			"source_file":      file,
+			"format":           string(ovirtclient.ImageFormatRaw),
+			"alias":            "test",
+		},
+	)
+	if diags := p.(*provider).diskFromImageCreate(context.Background(), data, client); diags.HasError() {
+		t.Fatalf("failed to create disk from image (%v)", diagsToError(diags))
+	}
+	if data.Get("sha256").(string) != checksum {
+		t.Fatalf("incorrect checksum in state: %s", data.Get("sha256"))
+	}
+
+	disk, err := client.GetDisk(data.Id())
+	if err != nil {
+		t.Fatalf("failed to fetch uploaded disk (%v)", err)
+	}
+	if disk.Format() != ovirtclient.ImageFormatRaw {
+		t.Fatalf("incorrect disk format: %s", disk.Format())
+	}
+	if disk.Alias() != "test" {
+		t.Fatalf("incorrect disk alias: %s", disk.Alias())
+	}
+}
+
+func TestDiskFromImageCreateNonSparse(t *testing.T) {
+	t.Parallel()
+
+	p := newProvider(ovirtclientlog.NewTestLogger(t))
+	client := p.getTestHelper().GetClient()
+	storageDomainID := p.getTestHelper().GetStorageDomainID()
+	file, _ := writeTestImage(t)
+
+	data := schema.TestResourceDataRaw(
+		t, diskFromImageSchema, map[string]interface{}{
+			"storagedomain_id": storageDomainID,
+			"source_file":      file,
+			"format":           string(ovirtclient.ImageFormatRaw),
+			"sparse":           false,
+		},
+	)
+
+	// The mock creates non-sparse disks by default, so the disk alone cannot show that sparse = false was passed
+	// to the client.
+	params, diags := diskFromImageCreateParams(data)
+	if diags.HasError() {
+		t.Fatalf("failed to build disk parameters (%v)", diagsToError(diags))
+	}
+	if params.Sparse() == nil || *params.Sparse() {
+		t.Fatalf("sparse = false is not passed to the client")
+	}
+
+	if diags := p.(*provider).diskFromImageCreate(context.Background(), data, client); diags.HasError() {
+		t.Fatalf("failed to create disk from image (%v)", diagsToError(diags))
+	}
+	disk, err := client.GetDisk(data.Id())
+	if err != nil {
+		t.Fatalf("failed to fetch uploaded disk (%v)", err)
+	}
+	if disk.Sparse() {
+		t.Fatalf("the disk was created as a sparse disk")
+	}
+	if data.Get("sparse").(bool) {
+		t.Fatalf("incorrect sparse value in state: true")
+	}
+}
+
+func TestDiskFromImageDiff(t *testing.T) {
+	t.Parallel()
+
+	p := newProvider(ovirtclientlog.NewTestLogger(t))
+	storageDomainID := p.getTestHelper().GetStorageDomainID()
+	file, checksum := writeTestImage(t)
+	diskResource := p.(*provider).diskFromImageResource()
+
+	// The engine reports a sparse disk with an alias, while the configuration sets neither.
+	state := &terraform.InstanceState{
+		ID: "00000000-0000-0000-0000-000000000001",
+		Attributes: map[string]string{
+			"id":               "00000000-0000-0000-0000-000000000001",
+			"storagedomain_id": storageDomainID,
+			"source_file":      file,
+			"sha256":           checksum,
+			"format":           string(ovirtclient.ImageFormatRaw),
+			"alias":            "test",
+			"sparse":           "true",
+		},
+	}
+	config := terraform.NewResourceConfigRaw(
+		map[string]interface{}{
+			"storagedomain_id": storageDomainID,
+			"source_file":      file,
+		},
+	)
+
+	diff, err := diskResource.SimpleDiff(context.Background(), state, config, nil)
+	if err != nil {
+		t.Fatalf("failed to calculate diff (%v)", err)
+	}
+	if diff != nil && diff.RequiresNew() {
+		t.Fatalf("omitting sparse and alias from the configuration requires replacing the disk")
+	}
+
+	// Changing the contents of the source file must replace the disk.
+	if err := os.WriteFile(file, bytes.Repeat([]byte{1}, 1024*1024), 0600); err != nil {
+		t.Fatalf("failed to overwrite test image (%v)", err)
+	}
+	diff, err = diskResource.SimpleDiff(context.Background(), state, config, nil)
+	if err != nil {
+		t.Fatalf("failed to calculate diff (%v)", err)
+	}
+	if diff == nil || !diff.RequiresNew() {
+		t.Fatalf("changing the source file does not replace the disk")
+	}
+}
+
+// blockingUpload is an upload that only finishes when its source is closed.
+type blockingUpload struct {
+	done chan struct{}
+	err  error
+}
+
+func (b *blockingUpload) Close() error {
+	b.err = fmt.Errorf("source closed")
+	close(b.done)
+	return nil
+}
+
+func (b *blockingUpload) Disk() ovirtclient.Disk {
+	return nil
+}
+
+func (b *blockingUpload) UploadedBytes() uint64 {
+	return 0
+}
+
+func (b *blockingUpload) TotalBytes() uint64 {
+	return 1024
+}
+
+func (b *blockingUpload) Err() error {
+	return b.err
+}
+
+func (b *blockingUpload) Done() <-chan struct{} {
+	return b.done
+}
+
+func TestWaitForUploadTimeout(t *testing.T) {
+	t.Parallel()
+
+	upload := &blockingUpload{done: make(chan struct{})}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := waitForUpload(ctx, upload, upload, ovirtclientlog.NewTestLogger(t))
+	if err == nil {
+		t.Fatalf("waiting for the upload did not time out")
+	}
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("unexpected error returned on timeout (%v)", err)
+	}
+	select {
+	case <-upload.Done():
+	default:
+		t.Fatalf("waitForUpload returned before the upload was aborted")
+	}
+}
+
+func TestRemoveFailedUpload(t *testing.T) {
+	t.Parallel()
+
+	p := newProvider(ovirtclientlog.NewTestLogger(t))
+	client := p.getTestHelper().GetClient()
+	disk, err := client.CreateDisk(
+		p.getTestHelper().GetStorageDomainID(),
+		ovirtclient.ImageFormatRaw,
+		1024*1024,
+		nil,
+	)
+	if err != nil {
+		t.Fatalf("failed to create disk (%v)", err)
+	}
+
+	logger := &recordingLogger{}
+	removeFailedUpload(client, disk, logger)
+	if _, err := client.GetDisk(disk.ID()); !isNotFound(err) {
+		t.Fatalf("the disk of the failed upload was not removed (%v)", err)
+	}
+	// The client may have removed the disk already, which must not result in a warning.
+	removeFailedUpload(client, disk, logger)
+	if len(logger.messages) != 0 {
+		t.Fatalf("unexpected log messages: %v", logger.messages)
+	}
+}
+
+func TestDiskFromImageReadRemoved(t *testing.T) {
+	t.Parallel()
+
+	p := newProvider(ovirtclientlog.NewTestLogger(t))
+	client := p.getTestHelper().GetClient()
+	file, _ := writeTestImage(t)
+
+	data := schema.TestResourceDataRaw(
+		t, diskFromImageSchema, map[string]interface{}{
+			"storagedomain_id": p.getTestHelper().GetStorageDomainID(),
+			"source_file":      file,
+			"format":           string(ovirtclient.ImageFormatRaw),
+		},
+	)
+	if diags := p.(*provider).diskFromImageCreate(context.Background(), data, client); diags.HasError() {
+		t.Fatalf("failed to create disk from image (%v)", diagsToError(diags))
+	}
+
+	// Remove the disk behind the back of Terraform, as if it was deleted in the oVirt Engine UI.
+	if err := client.RemoveDisk(data.Id()); err != nil {
+		t.Fatalf("failed to remove disk (%v)", err)
+	}
+	if diags := p.(*provider).diskRead(context.Background(), data, client); diags.HasError() {
+		t.Fatalf("reading a removed disk failed (%v)", diagsToError(diags))
+	}
+	if data.Id() != "" {
+		t.Fatalf("the removed disk was not removed from the state")
+	}
+}
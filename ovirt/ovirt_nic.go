@@ -51,12 +51,13 @@ func (p *provider) nicResource() *schema.Resource {
 	}
 }
 
-func (p *provider) nicCreate(ctx context.Context, data *schema.ResourceData, _ interface{}) diag.Diagnostics {
+func (p *provider) nicCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(ovirtclient.Client)
 	vmID := data.Get("vm_id").(string)
 	vnicProfileID := data.Get("vnic_profile_id").(string)
 	name := data.Get("name").(string)
 
-	nic, err := p.client.CreateNIC(vmID, vnicProfileID, name, nil, ovirtclient.ContextStrategy(ctx))
+	nic, err := client.CreateNIC(vmID, vnicProfileID, name, nil, ovirtclient.ContextStrategy(ctx))
 	if err != nil {
 		return errorToDiags("create NIC", err)
 	}
@@ -64,10 +65,11 @@ func (p *provider) nicCreate(ctx context.Context, data *schema.ResourceData, _ i
 	return nicResourceUpdate(nic, data)
 }
 
-func (p *provider) nicRead(ctx context.Context, data *schema.ResourceData, _ interface{}) diag.Diagnostics {
+func (p *provider) nicRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(ovirtclient.Client)
 	id := data.Id()
 	vmID := data.Get("vm_id").(string)
-	nic, err := p.client.GetNIC(vmID, id, ovirtclient.ContextStrategy(ctx))
+	nic, err := client.GetNIC(vmID, id, ovirtclient.ContextStrategy(ctx))
 	if err != nil {
 		if isNotFound(err) {
 			data.SetId("")
@@ -78,10 +80,11 @@ func (p *provider) nicRead(ctx context.Context, data *schema.ResourceData, _ int
 	return nicResourceUpdate(nic, data)
 }
 
-func (p *provider) nicDelete(ctx context.Context, data *schema.ResourceData, _ interface{}) diag.Diagnostics {
+func (p *provider) nicDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(ovirtclient.Client)
 	id := data.Id()
 	vmID := data.Get("vm_id").(string)
-	if err := p.client.RemoveNIC(vmID, id, ovirtclient.ContextStrategy(ctx)); err != nil {
+	if err := client.RemoveNIC(vmID, id, ovirtclient.ContextStrategy(ctx)); err != nil {
 		if !isNotFound(err) {
 			return errorToDiags("get NIC", err)
 		}
@@ -90,10 +93,11 @@ func (p *provider) nicDelete(ctx context.Context, data *schema.ResourceData, _ i
 	return nil
 }
 
-func (p *provider) nicImport(ctx context.Context, data *schema.ResourceData, _ interface{}) (
+func (p *provider) nicImport(ctx context.Context, data *schema.ResourceData, meta interface{}) (
 	[]*schema.ResourceData,
 	error,
 ) {
+	client := meta.(ovirtclient.Client)
 	importID := data.Id()
 
 	parts := strings.SplitN(importID, "/", 2)
@@ -102,7 +106,7 @@ func (p *provider) nicImport(ctx context.Context, data *schema.ResourceData, _ i
 			"invalid import specification, the ID should be specified as: VMID/NICID",
 		)
 	}
-	nic, err := p.client.GetNIC(parts[0], parts[1], ovirtclient.ContextStrategy(ctx))
+	nic, err := client.GetNIC(parts[0], parts[1], ovirtclient.ContextStrategy(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -67,8 +67,9 @@ func (p *provider) vmResource() *schema.Resource {
 func (p *provider) vmCreate(
 	ctx context.Context,
 	data *schema.ResourceData,
-	_ interface{},
+	meta interface{},
 ) diag.Diagnostics {
+	client := meta.(ovirtclient.Client)
 	clusterID := data.Get("cluster_id").(string)
 	templateID := data.Get("template_id").(string)
 
@@ -98,7 +99,7 @@ func (p *provider) vmCreate(
 		}
 	}
 
-	vm, err := p.client.CreateVM(clusterID, templateID, params, ovirtclient.ContextStrategy(ctx))
+	vm, err := client.CreateVM(clusterID, templateID, params, ovirtclient.ContextStrategy(ctx))
 	if err != nil {
 		return diag.Diagnostics{
 			diag.Diagnostic{
@@ -115,10 +116,11 @@ func (p *provider) vmCreate(
 func (p *provider) vmRead(
 	ctx context.Context,
 	data *schema.ResourceData,
-	_ interface{},
+	meta interface{},
 ) diag.Diagnostics {
+	client := meta.(ovirtclient.Client)
 	id := data.Id()
-	vm, err := p.client.GetVM(id, ovirtclient.ContextStrategy(ctx))
+	vm, err := client.GetVM(id, ovirtclient.ContextStrategy(ctx))
 	if err != nil {
 		if isNotFound(err) {
 			data.SetId("")
@@ -147,8 +149,9 @@ func vmResourceUpdate(vm ovirtclient.VMData, data *schema.ResourceData) diag.Dia
 	return diags
 }
 
-func (p *provider) vmDelete(ctx context.Context, data *schema.ResourceData, _ interface{}) diag.Diagnostics {
-	if err := p.client.RemoveVM(data.Id(), ovirtclient.ContextStrategy(ctx)); err != nil {
+func (p *provider) vmDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(ovirtclient.Client)
+	if err := client.RemoveVM(data.Id(), ovirtclient.ContextStrategy(ctx)); err != nil {
 		if isNotFound(err) {
 			data.SetId("")
 			return nil
@@ -166,7 +169,8 @@ func (p *provider) vmDelete(ctx context.Context, data *schema.ResourceData, _ in
 	return nil
 }
 
-func (p *provider) vmUpdate(ctx context.Context, data *schema.ResourceData, _ interface{}) diag.Diagnostics {
+func (p *provider) vmUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(ovirtclient.Client)
 	diags := diag.Diagnostics{}
 	params := ovirtclient.UpdateVMParams()
 	if name, ok := data.GetOk("name"); ok {
@@ -199,7 +203,7 @@ func (p *provider) vmUpdate(ctx context.Context, data *schema.ResourceData, _ in
 		return diags
 	}
 
-	vm, err := p.client.UpdateVM(data.Id(), params, ovirtclient.ContextStrategy(ctx))
+	vm, err := client.UpdateVM(data.Id(), params, ovirtclient.ContextStrategy(ctx))
 	if isNotFound(err) {
 		data.SetId("")
 	}
@@ -217,11 +221,12 @@ func (p *provider) vmUpdate(ctx context.Context, data *schema.ResourceData, _ in
 	return vmResourceUpdate(vm, data)
 }
 
-func (p *provider) vmImport(ctx context.Context, data *schema.ResourceData, _ interface{}) (
+func (p *provider) vmImport(ctx context.Context, data *schema.ResourceData, meta interface{}) (
 	[]*schema.ResourceData,
 	error,
 ) {
-	vm, err := p.client.GetVM(data.Id(), ovirtclient.ContextStrategy(ctx))
+	client := meta.(ovirtclient.Client)
+	vm, err := client.GetVM(data.Id(), ovirtclient.ContextStrategy(ctx))
 	if err != nil {
 		return nil, fmt.Errorf("failed to import VM %s (%w)", data.Id(), err)
 	}
@@ -0,0 +1,193 @@
+package ovirt
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// defaultProfileFile is the location of the profile file if the profile_file option is not set. The leading ~ is
+// replaced with the home directory of the current user.
+const defaultProfileFile = "~/.ovirt/ovirt.conf"
+
+// connectionProfile holds the connection settings loaded from a named section of an ovirt.conf-style profile file.
+type connectionProfile struct {
+	url      string
+	username string
+	password string
+	caFile   string
+	insecure *bool
+}
+
+// loadConnectionProfile reads the profile file and returns the settings from the section matching the profile name.
+// The file uses the INI format ovirt-shell and the Ansible oVirt inventory use, for example:
+//
+//	[production]
+//	url = https://engine.example.com/ovirt-engine/api
+//	username = admin@internal
+//	password = secret
+//	ca_file = /etc/pki/ovirt-engine/ca.pem
+//
+// Keys may also carry the ovirt_ prefix (e.g. ovirt_url) as used by Ansible.
+func loadConnectionProfile(file string, name string) (connectionProfile, error) {
+	result := connectionProfile{}
+	path, err := expandHome(file)
+	if err != nil {
+		return result, err
+	}
+	fh, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return result, fmt.Errorf("failed to open profile file %s (%w)", path, err)
+	}
+	defer func() {
+		_ = fh.Close()
+	}()
+
+	found := false
+	currentSection := ""
+	scanner := bufio.NewScanner(fh)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+		if strings.HasPrefix(line, "[") {
+			if !strings.HasSuffix(line, "]") {
+				return result, fmt.Errorf("invalid section header in %s line %d: %s", path, lineNumber, line)
+			}
+			currentSection = strings.TrimSpace(line[1 : len(line)-1])
+			if currentSection == name {
+				found = true
+			}
+			continue
+		}
+		if currentSection != name {
+			continue
+		}
+		parts := strings.SplitN(line, "=", 2)
+		if len(parts) != 2 {
+			parts = strings.SplitN(line, ":", 2)
+		}
+		if len(parts) != 2 {
+			return result, fmt.Errorf("invalid line in %s line %d, expected key = value", path, lineNumber)
+		}
+		if err := result.set(strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1])); err != nil {
+			return result, fmt.Errorf("invalid value in %s line %d (%w)", path, lineNumber, err)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return result, fmt.Errorf("failed to read profile file %s (%w)", path, err)
+	}
+	if !found {
+		return result, fmt.Errorf("profile %s not found in %s", name, path)
+	}
+	return result, nil
+}
+
+func (c *connectionProfile) set(key string, value string) error {
+	switch strings.TrimPrefix(strings.ToLower(key), "ovirt_") {
+	case "url":
+		c.url = value
+	case "username":
+		c.username = value
+	case "password":
+		c.password = value
+	case "ca_file":
+		c.caFile = value
+	case "insecure":
+		insecure, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("the insecure option must be a boolean, got %s", value)
+		}
+		c.insecure = &insecure
+	}
+	// Unknown keys are ignored since the profile file may be shared with other tools.
+	return nil
+}
+
+func expandHome(path string) (string, error) {
+	if path != "~" && !strings.HasPrefix(path, "~/") {
+		return path, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to determine home directory for %s (%w)", path, err)
+	}
+	return filepath.Join(home, strings.TrimPrefix(path, "~")), nil
+}
+
+// extractProfile loads the connection profile selected by the profile option. If no profile is selected an empty
+// profile is returned.
+func extractProfile(data *schema.ResourceData, diags diag.Diagnostics) (connectionProfile, diag.Diagnostics) {
+	name, ok := data.GetOk("profile")
+	if !ok {
+		return connectionProfile{}, diags
+	}
+	file := data.Get("profile_file").(string)
+	if file == "" {
+		file = defaultProfileFile
+	}
+	profile, err := loadConnectionProfile(file, name.(string))
+	if err != nil {
+		diags = append(
+			diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Failed to load the %s profile", name),
+				Detail:   err.Error(),
+			},
+		)
+	}
+	return profile, diags
+}
+
+// extractInsecure returns if certificate verification should be disabled. The profile is only consulted if the
+// tls_insecure option is neither set in the configuration nor through the environment, so that an explicit false is
+// not overridden by the profile.
+func extractInsecure(data *schema.ResourceData, profile connectionProfile) bool {
+	if insecure, ok := data.GetOkExists("tls_insecure"); ok { //nolint:staticcheck
+		return insecure.(bool)
+	}
+	return profile.insecure != nil && *profile.insecure
+}
+
+// extractCAFiles returns the CA files to verify the engine certificate against. The tls_ca_files option takes
+// precedence over the OVIRT_CAFILE environment variable, which in turn takes precedence over the profile.
+func extractCAFiles(
+	data *schema.ResourceData,
+	profile connectionProfile,
+	diags diag.Diagnostics,
+) ([]string, diag.Diagnostics) {
+	if caFiles, ok := data.GetOk("tls_ca_files"); ok {
+		caFileList, ok := caFiles.([]interface{})
+		if !ok {
+			diags = append(
+				diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "The tls_ca_files option is not a list of files",
+					Detail:   "The tls_ca_files option must be a list of files containing PEM-formatted certificates",
+				},
+			)
+			return nil, diags
+		}
+		result := make([]string, len(caFileList))
+		for i, caFile := range caFileList {
+			result[i] = caFile.(string)
+		}
+		return result, diags
+	}
+	if caFile := os.Getenv("OVIRT_CAFILE"); caFile != "" {
+		return []string{caFile}, diags
+	}
+	if profile.caFile != "" {
+		return []string{profile.caFile}, diags
+	}
+	return nil, diags
+}
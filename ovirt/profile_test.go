@@ -0,0 +1,250 @@
+package ovirt
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const testProfileFile = `# Profiles for the oVirt engines.
+[staging]
+url = https://staging.example.com/ovirt-engine/api
+username = admin@internal
+password = staging-secret
+insecure = true
+
+[production]
+ovirt_url = https://production.example.com/ovirt-engine/api
+ovirt_username = terraform@internal
+ovirt_password = production-secret
+ovirt_ca_file = /etc/pki/ovirt-engine/ca.pem
+`
+
+func writeTestProfileFile(t *testing.T) string {
+	file := filepath.Join(t.TempDir(), "ovirt.conf")
+	if err := os.WriteFile(file, []byte(testProfileFile), 0600); err != nil {
+		t.Fatalf("failed to write test profile file (%v)", err)
+	}
+	return file
+}
+
+func TestLoadConnectionProfile(t *testing.T) {
+	t.Parallel()
+
+	file := writeTestProfileFile(t)
+
+	staging, err := loadConnectionProfile(file, "staging")
+	if err != nil {
+		t.Fatalf("failed to load staging profile (%v)", err)
+	}
+	if staging.url != "https://staging.example.com/ovirt-engine/api" {
+		t.Fatalf("incorrect URL in staging profile: %s", staging.url)
+	}
+	if staging.password != "staging-secret" {
+		t.Fatalf("incorrect password in staging profile: %s", staging.password)
+	}
+	if staging.insecure == nil || !*staging.insecure {
+		t.Fatalf("the insecure option was not loaded from the staging profile")
+	}
+
+	production, err := loadConnectionProfile(file, "production")
+	if err != nil {
+		t.Fatalf("failed to load production profile (%v)", err)
+	}
+	if production.username != "terraform@internal" {
+		t.Fatalf("incorrect username in production profile: %s", production.username)
+	}
+	if production.caFile != "/etc/pki/ovirt-engine/ca.pem" {
+		t.Fatalf("incorrect CA file in production profile: %s", production.caFile)
+	}
+	if production.insecure != nil {
+		t.Fatalf("the insecure option leaked into the production profile")
+	}
+
+	if _, err := loadConnectionProfile(file, "nonexistent"); err == nil {
+		t.Fatalf("loading a nonexistent profile did not result in an error")
+	}
+}
+
+// providerEnvironmentVariables are the environment variables the provider reads its connection settings from.
+var providerEnvironmentVariables = []string{
+	"OVIRT_URL",
+	"OVIRT_USERNAME",
+	"OVIRT_PASSWORD",
+	"OVIRT_INSECURE",
+	"OVIRT_TLS_SYSTEM",
+	"OVIRT_CA_BUNDLE",
+	"OVIRT_CAFILE",
+	"OVIRT_PROFILE",
+	"OVIRT_PROFILE_FILE",
+}
+
+// clearProviderEnvironment unsets the provider environment variables for the duration of the test, so that settings
+// exported on the machine running the tests do not override the test configuration. Tests calling this function must
+// not run in parallel.
+func clearProviderEnvironment(t *testing.T) {
+	for _, name := range providerEnvironmentVariables {
+		name := name
+		if value, ok := os.LookupEnv(name); ok {
+			t.Cleanup(func() {
+				_ = os.Setenv(name, value)
+			})
+		}
+		if err := os.Unsetenv(name); err != nil {
+			t.Fatalf("failed to unset %s (%v)", name, err)
+		}
+	}
+}
+
+// setProviderEnvironment sets an environment variable for the duration of the test. Tests calling this function must
+// not run in parallel.
+func setProviderEnvironment(t *testing.T, name string, value string) {
+	previous, ok := os.LookupEnv(name)
+	t.Cleanup(func() {
+		if ok {
+			_ = os.Setenv(name, previous)
+		} else {
+			_ = os.Unsetenv(name)
+		}
+	})
+	if err := os.Setenv(name, value); err != nil {
+		t.Fatalf("failed to set %s (%v)", name, err)
+	}
+}
+
+func TestExtractProfile(t *testing.T) {
+	clearProviderEnvironment(t)
+
+	file := writeTestProfileFile(t)
+	data := schema.TestResourceDataRaw(t, providerSchema, map[string]interface{}{
+		"profile":      "production",
+		"profile_file": file,
+		"username":     "override@internal",
+	})
+
+	profile, diags := extractProfile(data, nil)
+	if diags.HasError() {
+		t.Fatalf("failed to extract profile (%v)", diags)
+	}
+	url, diags := extractString(data, "url", profile.url, diags)
+	username, diags := extractString(data, "username", profile.username, diags)
+	if diags.HasError() {
+		t.Fatalf("failed to extract connection settings (%v)", diags)
+	}
+	if url != "https://production.example.com/ovirt-engine/api" {
+		t.Fatalf("the URL was not taken from the profile: %s", url)
+	}
+	if username != "override@internal" {
+		t.Fatalf("the username set in the configuration did not take precedence over the profile: %s", username)
+	}
+}
+
+func TestExtractInsecure(t *testing.T) {
+	file := writeTestProfileFile(t)
+
+	testCases := []struct {
+		name        string
+		config      map[string]interface{}
+		environment string
+		expected    bool
+	}{
+		{
+			name:     "profile",
+			config:   map[string]interface{}{},
+			expected: true,
+		},
+		{
+			name: "configuration overrides profile",
+			config: map[string]interface{}{
+				"tls_insecure": false,
+			},
+			expected: false,
+		},
+		{
+			name:        "environment overrides profile",
+			config:      map[string]interface{}{},
+			environment: "false",
+			expected:    false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			clearProviderEnvironment(t)
+			if testCase.environment != "" {
+				setProviderEnvironment(t, "OVIRT_INSECURE", testCase.environment)
+			}
+			config := map[string]interface{}{
+				"profile":      "staging",
+				"profile_file": file,
+			}
+			for key, value := range testCase.config {
+				config[key] = value
+			}
+			data := schema.TestResourceDataRaw(t, providerSchema, config)
+
+			profile, diags := extractProfile(data, nil)
+			if diags.HasError() {
+				t.Fatalf("failed to extract profile (%v)", diags)
+			}
+			if insecure := extractInsecure(data, profile); insecure != testCase.expected {
+				t.Fatalf("incorrect insecure value: %t, expected: %t", insecure, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestExtractEnvironment(t *testing.T) {
+	clearProviderEnvironment(t)
+	setProviderEnvironment(t, "OVIRT_URL", "https://environment.example.com/ovirt-engine/api")
+	setProviderEnvironment(t, "OVIRT_USERNAME", "environment@internal")
+	setProviderEnvironment(t, "OVIRT_PASSWORD", "environment-secret")
+	setProviderEnvironment(t, "OVIRT_CAFILE", "/etc/pki/environment/ca.pem")
+
+	file := writeTestProfileFile(t)
+	data := schema.TestResourceDataRaw(t, providerSchema, map[string]interface{}{
+		"profile":      "production",
+		"profile_file": file,
+		"username":     "override@internal",
+	})
+
+	profile, diags := extractProfile(data, nil)
+	if diags.HasError() {
+		t.Fatalf("failed to extract profile (%v)", diags)
+	}
+	url, diags := extractString(data, "url", profile.url, diags)
+	username, diags := extractString(data, "username", profile.username, diags)
+	password, diags := extractString(data, "password", profile.password, diags)
+	caFiles, diags := extractCAFiles(data, profile, diags)
+	if diags.HasError() {
+		t.Fatalf("failed to extract connection settings (%v)", diags)
+	}
+	if url != "https://environment.example.com/ovirt-engine/api" {
+		t.Fatalf("the URL was not taken from the environment: %s", url)
+	}
+	if password != "environment-secret" {
+		t.Fatalf("the password was not taken from the environment: %s", password)
+	}
+	if username != "override@internal" {
+		t.Fatalf("the username set in the configuration did not take precedence over the environment: %s", username)
+	}
+	if len(caFiles) != 1 || caFiles[0] != "/etc/pki/environment/ca.pem" {
+		t.Fatalf("the CA file set in the environment did not take precedence over the profile: %v", caFiles)
+	}
+
+	data = schema.TestResourceDataRaw(t, providerSchema, map[string]interface{}{
+		"profile":      "production",
+		"profile_file": file,
+		"tls_ca_files": []interface{}{"/etc/pki/configuration/ca.pem"},
+	})
+	caFiles, diags = extractCAFiles(data, profile, nil)
+	if diags.HasError() {
+		t.Fatalf("failed to extract CA files (%v)", diags)
+	}
+	if len(caFiles) != 1 || caFiles[0] != "/etc/pki/configuration/ca.pem" {
+		t.Fatalf("the CA files set in the configuration did not take precedence over the environment: %v", caFiles)
+	}
+}
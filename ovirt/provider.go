@@ -17,18 +17,21 @@ var providerSchema = map[string]*schema.Schema{
 	"username": {
 		Type:        schema.TypeString,
 		Optional:    true,
-		Description: "Username and realm for oVirt authentication. Required when mock = false. Example: `admin@internal`",
+		DefaultFunc: schema.EnvDefaultFunc("OVIRT_USERNAME", nil),
+		Description: "Username and realm for oVirt authentication. Required when mock = false. Can also be set using the `OVIRT_USERNAME` environment variable or the selected `profile`. Example: `admin@internal`",
 	},
 	"password": {
 		Type:        schema.TypeString,
 		Optional:    true,
 		Sensitive:   true,
-		Description: "Password for oVirt authentication. Required when mock = false.",
+		DefaultFunc: schema.EnvDefaultFunc("OVIRT_PASSWORD", nil),
+		Description: "Password for oVirt authentication. Required when mock = false. Can also be set using the `OVIRT_PASSWORD` environment variable or the selected `profile`.",
 	},
 	"url": {
 		Type:        schema.TypeString,
 		Optional:    true,
-		Description: "URL for the oVirt engine API. Required when mock = false. Example: `https://example.com/ovirt-engine/api/`",
+		DefaultFunc: schema.EnvDefaultFunc("OVIRT_URL", nil),
+		Description: "URL for the oVirt engine API. Required when mock = false. Can also be set using the `OVIRT_URL` environment variable or the selected `profile`. Example: `https://example.com/ovirt-engine/api/`",
 	},
 	"extra_headers": {
 		Type:        schema.TypeMap,
@@ -39,25 +42,28 @@ var providerSchema = map[string]*schema.Schema{
 	"tls_insecure": {
 		Type:             schema.TypeBool,
 		Optional:         true,
+		DefaultFunc:      schema.EnvDefaultFunc("OVIRT_INSECURE", nil),
 		ValidateDiagFunc: validateTLSInsecure,
-		Description:      "Disable certificate verification when connecting the Engine. This is not recommended. Setting this option is incompatible with other `tls_` options.",
+		Description:      "Disable certificate verification when connecting the Engine. This is not recommended. Setting this option is incompatible with other `tls_` options. Can also be set using the `OVIRT_INSECURE` environment variable or the `insecure` key of the selected `profile`.",
 	},
 	"tls_system": {
 		Type:             schema.TypeBool,
 		Optional:         true,
+		DefaultFunc:      schema.EnvDefaultFunc("OVIRT_TLS_SYSTEM", nil),
 		ValidateDiagFunc: validateTLSSystem,
-		Description:      "Use the system certificate pool to verify the Engine certificate. This does not work on Windows. Can be used in parallel with other `tls_` options, one tls_ option is required when mock = false.",
+		Description:      "Use the system certificate pool to verify the Engine certificate. This does not work on Windows. Can be used in parallel with other `tls_` options, one tls_ option is required when mock = false. Can also be set using the `OVIRT_TLS_SYSTEM` environment variable.",
 	},
 	"tls_ca_bundle": {
 		Type:        schema.TypeString,
 		Optional:    true,
-		Description: "Validate the Engine certificate against the provided CA certificates. The certificate chain passed should be in PEM format. Can be used in parallel with other `tls_` options, one `tls_` option is required when mock = false.",
+		DefaultFunc: schema.EnvDefaultFunc("OVIRT_CA_BUNDLE", nil),
+		Description: "Validate the Engine certificate against the provided CA certificates. The certificate chain passed should be in PEM format. Can be used in parallel with other `tls_` options, one `tls_` option is required when mock = false. Can also be set using the `OVIRT_CA_BUNDLE` environment variable.",
 	},
 	"tls_ca_files": {
 		Type:        schema.TypeList,
 		Elem:        &schema.Schema{Type: schema.TypeString},
 		Optional:    true,
-		Description: "Validate the Engine certificate against the CA certificates provided in the files in this parameter. The files should contain certificates in PEM format. Can be used in parallel with other tls_ options, one tls_ option is required when mock = false.",
+		Description: "Validate the Engine certificate against the CA certificates provided in the files in this parameter. The files should contain certificates in PEM format. Can be used in parallel with other tls_ options, one tls_ option is required when mock = false. If not set, a single file can be provided using the `OVIRT_CAFILE` environment variable or the `ca_file` key of the selected `profile`.",
 		// Validating TypeList fields is not yet supported in Terraform.
 		//ValidateDiagFunc: validateFilesExist,
 	},
@@ -69,6 +75,18 @@ var providerSchema = map[string]*schema.Schema{
 		// Validating TypeList fields is not yet supported in Terraform.
 		//ValidateDiagFunc: validateDirsExist,
 	},
+	"profile": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		DefaultFunc: schema.EnvDefaultFunc("OVIRT_PROFILE", nil),
+		Description: "Name of the section in `profile_file` to load the connection settings from. Options set directly or through environment variables take precedence over the profile. Can also be set using the `OVIRT_PROFILE` environment variable.",
+	},
+	"profile_file": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		DefaultFunc: schema.EnvDefaultFunc("OVIRT_PROFILE_FILE", defaultProfileFile),
+		Description: "Path to an ovirt.conf-style INI file containing named profiles. Each section may contain the `url`, `username`, `password`, `ca_file`, and `insecure` keys, optionally prefixed with `ovirt_`. Only used when `profile` is set. Can also be set using the `OVIRT_PROFILE_FILE` environment variable. Defaults to `" + defaultProfileFile + "`.",
+	},
 	"mock": {
 		Type:        schema.TypeBool,
 		Optional:    true,
@@ -79,7 +97,7 @@ var providerSchema = map[string]*schema.Schema{
 
 // New returns a new Terraform provider schema for oVirt.
 func New() func() *schema.Provider {
-	return newProvider(ovirtclientlog.NewNOOPLogger()).getProvider
+	return newProvider(newTerraformLogger()).getProvider
 }
 
 func newProvider(logger ovirtclientlog.Logger) providerInterface {
@@ -100,6 +118,7 @@ func newProvider(logger ovirtclientlog.Logger) providerInterface {
 	}
 	return &provider{
 		testHelper: helper,
+		logger:     newRedactingLogger(logger),
 	}
 }
 
@@ -109,9 +128,12 @@ type providerInterface interface {
 	getProviderFactories() map[string]func() (*schema.Provider, error)
 }
 
+// provider holds the state shared between all configurations of the provider. The oVirt client is not stored here
+// as each provider configuration (e.g. aliases) may point to a different engine. Instead, configureProvider returns
+// the client, which Terraform then passes to each resource function as the meta parameter.
 type provider struct {
 	testHelper ovirtclient.TestHelper
-	client     ovirtclient.Client
+	logger     ovirtclientlog.Logger
 }
 
 func (p *provider) getTestHelper() ovirtclient.TestHelper {
@@ -123,13 +145,21 @@ func (p *provider) getProvider() *schema.Provider {
 		Schema:               providerSchema,
 		ConfigureContextFunc: p.configureProvider,
 		ResourcesMap: map[string]*schema.Resource{
-			"ovirt_vm":               p.vmResource(),
-			"ovirt_disk":             p.diskResource(),
-			"ovirt_disk_attachment":  p.diskAttachmentResource(),
-			"ovirt_disk_attachments": p.diskAttachmentsResource(),
-			"ovirt_nic":              p.nicResource(),
+			"ovirt_vm":               p.logResourceOperations("ovirt_vm", p.vmResource()),
+			"ovirt_disk":             p.logResourceOperations("ovirt_disk", p.diskResource()),
+			"ovirt_disk_from_image":  p.logResourceOperations("ovirt_disk_from_image", p.diskFromImageResource()),
+			"ovirt_disk_attachment":  p.logResourceOperations("ovirt_disk_attachment", p.diskAttachmentResource()),
+			"ovirt_disk_attachments": p.logResourceOperations("ovirt_disk_attachments", p.diskAttachmentsResource()),
+			"ovirt_nic":              p.logResourceOperations("ovirt_nic", p.nicResource()),
+		},
+		DataSourcesMap: map[string]*schema.Resource{
+			"ovirt_cluster":         p.logResourceOperations("ovirt_cluster", p.clusterDataSource()),
+			"ovirt_clusters":        p.logResourceOperations("ovirt_clusters", p.clustersDataSource()),
+			"ovirt_storage_domain":  p.logResourceOperations("ovirt_storage_domain", p.storageDomainDataSource()),
+			"ovirt_storage_domains": p.logResourceOperations("ovirt_storage_domains", p.storageDomainsDataSource()),
+			"ovirt_template":        p.logResourceOperations("ovirt_template", p.templateDataSource()),
+			"ovirt_vnic_profile":    p.logResourceOperations("ovirt_vnic_profile", p.vnicProfileDataSource()),
 		},
-		DataSourcesMap: map[string]*schema.Resource{},
 	}
 }
 
@@ -145,39 +175,27 @@ func (p *provider) configureProvider(_ context.Context, data *schema.ResourceDat
 	diags := diag.Diagnostics{}
 
 	if mock, ok := data.GetOk("mock"); ok && mock == true {
-		p.client = p.testHelper.GetClient()
-		return p, diags
+		return p.testHelper.GetClient(), diags
 	}
 
-	url, diags := extractString(data, "url", diags)
-	username, diags := extractString(data, "username", diags)
-	password, diags := extractString(data, "password", diags)
+	profile, diags := extractProfile(data, diags)
+	url, diags := extractString(data, "url", profile.url, diags)
+	username, diags := extractString(data, "username", profile.username, diags)
+	password, diags := extractString(data, "password", profile.password, diags)
 
 	tls := ovirtclient.TLS()
-	if insecure, ok := data.GetOk("tls_insecure"); ok && insecure == true {
+	if extractInsecure(data, profile) {
 		tls.Insecure()
 	}
 	if system, ok := data.GetOk("tls_system"); ok && system == true {
 		tls.CACertsFromSystem()
 	}
-	if caFiles, ok := data.GetOk("tls_ca_files"); ok {
-		caFileList, ok := caFiles.([]string)
-		if !ok {
-			diags = append(
-				diags, diag.Diagnostic{
-					Severity: diag.Error,
-					Summary:  "The tls_ca_files option is not a list of files",
-					Detail:   "The tls_ca_files option must be a list of files containing PEM-formatted certificates",
-				},
-			)
-		} else {
-			for _, caFile := range caFileList {
-				tls.CACertsFromFile(caFile)
-			}
-		}
+	caFiles, diags := extractCAFiles(data, profile, diags)
+	for _, caFile := range caFiles {
+		tls.CACertsFromFile(caFile)
 	}
 	if caDirs, ok := data.GetOk("tls_ca_dirs"); ok {
-		caDirList, ok := caDirs.([]string)
+		caDirList, ok := caDirs.([]interface{})
 		if !ok {
 			diags = append(
 				diags, diag.Diagnostic{
@@ -188,7 +206,7 @@ func (p *provider) configureProvider(_ context.Context, data *schema.ResourceDat
 			)
 		} else {
 			for _, caDir := range caDirList {
-				tls.CACertsFromDir(caDir)
+				tls.CACertsFromDir(caDir.(string))
 			}
 		}
 	}
@@ -211,12 +229,13 @@ func (p *provider) configureProvider(_ context.Context, data *schema.ResourceDat
 		return nil, diags
 	}
 
+	// The client logs carry no resource context as the client is shared between all resources of this configuration.
 	client, err := ovirtclient.New(
 		url,
 		username,
 		password,
 		tls,
-		ovirtclientlog.NewNOOPLogger(),
+		newRedactingLogger(p.logger, password),
 		nil,
 	)
 	if err != nil {
@@ -230,6 +249,5 @@ func (p *provider) configureProvider(_ context.Context, data *schema.ResourceDat
 		)
 		return nil, diags
 	}
-	p.client = client
-	return p, diags
+	return client, diags
 }
@@ -1,7 +1,12 @@
 package ovirt
 
 import (
+	"context"
 	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ovirtclient "github.com/ovirt/go-ovirt-client"
+	ovirtclientlog "github.com/ovirt/go-ovirt-client-log/v2"
 )
 
 func TestProvider(t *testing.T) {
@@ -11,3 +16,56 @@ func TestProvider(t *testing.T) {
 		t.Fatalf("err: %s", err)
 	}
 }
+
+// TestProviderMetaIsolation checks that the resource functions of a single provider use the client passed as meta,
+// so that two provider configurations (e.g. aliases) pointing to different engines do not interfere.
+func TestProviderMetaIsolation(t *testing.T) {
+	t.Parallel()
+
+	p := newProvider(ovirtclientlog.NewTestLogger(t))
+	primaryHelper := p.getTestHelper()
+	secondaryHelper := newProvider(ovirtclientlog.NewTestLogger(t)).getTestHelper()
+	primary := primaryHelper.GetClient()
+	secondary := secondaryHelper.GetClient()
+
+	createVM := func(helper ovirtclient.TestHelper, name string) *schema.ResourceData {
+		data := schema.TestResourceDataRaw(
+			t, vmSchema, map[string]interface{}{
+				"cluster_id":  helper.GetClusterID(),
+				"template_id": helper.GetBlankTemplateID(),
+				"name":        name,
+			},
+		)
+		if diags := p.(*provider).vmCreate(context.Background(), data, helper.GetClient()); diags.HasError() {
+			t.Fatalf("failed to create VM %s (%v)", name, diagsToError(diags))
+		}
+		return data
+	}
+	primaryVM := createVM(primaryHelper, "primary")
+	secondaryVM := createVM(secondaryHelper, "secondary")
+
+	assertVMOnlyOn := func(vm *schema.ResourceData, own ovirtclient.Client, other ovirtclient.Client) {
+		if _, err := own.GetVM(vm.Id()); err != nil {
+			t.Fatalf("VM %s was not created on its own engine (%v)", vm.Id(), err)
+		}
+		if _, err := other.GetVM(vm.Id()); !isNotFound(err) {
+			t.Fatalf("VM %s was found on the engine of the other provider configuration", vm.Id())
+		}
+	}
+	assertVMOnlyOn(primaryVM, primary, secondary)
+	assertVMOnlyOn(secondaryVM, secondary, primary)
+
+	// Reading a VM through the wrong engine must not find it, while reading it through its own engine must.
+	if diags := p.(*provider).vmRead(context.Background(), secondaryVM, secondary); diags.HasError() {
+		t.Fatalf("failed to read the secondary VM (%v)", diagsToError(diags))
+	}
+	if secondaryVM.Id() == "" {
+		t.Fatalf("the secondary VM was not found on its own engine")
+	}
+	if diags := p.(*provider).vmRead(context.Background(), primaryVM, secondary); diags.HasError() {
+		t.Fatalf("failed to read the primary VM (%v)", diagsToError(diags))
+	}
+	if primaryVM.Id() != "" {
+		t.Fatalf("the primary VM was found on the secondary engine")
+	}
+}
@@ -10,15 +10,28 @@ import (
 	ovirtclient "github.com/ovirt/go-ovirt-client"
 )
 
-func extractString(data *schema.ResourceData, option string, diags diag.Diagnostics) (string, diag.Diagnostics) {
+// extractString reads a string option from the provider configuration. If the option is not set, the fallback value
+// (e.g. from a profile) is used. If neither is set an error diagnostic is added.
+func extractString(
+	data *schema.ResourceData,
+	option string,
+	fallback string,
+	diags diag.Diagnostics,
+) (string, diag.Diagnostics) {
 	var url string
 	urlInterface, ok := data.GetOk(option)
 	if !ok {
+		if fallback != "" {
+			return fallback, diags
+		}
 		diags = append(
 			diags, diag.Diagnostic{
 				Severity: diag.Error,
 				Summary:  fmt.Sprintf("The %s option is not set", option),
-				Detail:   fmt.Sprintf("The %s option must be set if mock=false", option),
+				Detail: fmt.Sprintf(
+					"The %s option must be set if mock=false, either directly, through the environment, or in the selected profile",
+					option,
+				),
 			},
 		)
 	} else {
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"regexp"
 	"runtime"
+	"strings"
 
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -201,3 +202,118 @@ func validateUUID(i interface{}, path cty.Path) diag.Diagnostics {
 	}
 	return nil
 }
+
+func validateRegexp(i interface{}, path cty.Path) diag.Diagnostics {
+	val, ok := i.(string)
+	if !ok {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       "Not a string",
+				Detail:        "The specified value is not a string, but must be a string containing a regular expression.",
+				AttributePath: path,
+			},
+		}
+	}
+
+	if _, err := regexp.Compile(val); err != nil {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       "Invalid regular expression",
+				Detail:        err.Error(),
+				AttributePath: path,
+			},
+		}
+	}
+	return nil
+}
+
+func validateStorageDomainStatus(i interface{}, path cty.Path) diag.Diagnostics {
+	val, ok := i.(string)
+	if !ok {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       "The status should be a string.",
+				Detail:        "The provided status value is not a string.",
+				AttributePath: path,
+			},
+		}
+	}
+	var validValues []string
+	for _, status := range ovirtclient.StorageDomainStatusValues() {
+		if status == ovirtclient.StorageDomainStatusNA {
+			continue
+		}
+		if string(status) == val {
+			return nil
+		}
+		validValues = append(validValues, string(status))
+	}
+	return diag.Diagnostics{
+		diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Invalid storage domain status.",
+			Detail: fmt.Sprintf(
+				"The storage domain status must be one of: %s, got %s.",
+				strings.Join(validValues, ", "),
+				val,
+			),
+			AttributePath: path,
+		},
+	}
+}
+
+func validateNonNegative(i interface{}, path cty.Path) diag.Diagnostics {
+	val, ok := i.(int)
+	if !ok {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       "Value must be an integer.",
+				Detail:        "The provided value is not an integer.",
+				AttributePath: path,
+			},
+		}
+	}
+	if val < 0 {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       "Value must not be negative.",
+				Detail:        fmt.Sprintf("The provided value must be zero or a positive integer, got %d.", val),
+				AttributePath: path,
+			},
+		}
+	}
+	return nil
+}
+
+var sha256Regexp = regexp.MustCompile(`^[0-9a-fA-F]{64}$`)
+
+func validateSHA256(i interface{}, path cty.Path) diag.Diagnostics {
+	val, ok := i.(string)
+	if !ok {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       "Not a string",
+				Detail:        "The specified value is not a string, but must be a string containing a SHA-256 checksum.",
+				AttributePath: path,
+			},
+		}
+	}
+
+	if !sha256Regexp.MatchString(val) {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       "Not a SHA-256 checksum",
+				Detail:        "The specified value is not a hex-encoded SHA-256 checksum.",
+				AttributePath: path,
+			},
+		}
+	}
+	return nil
+}